@@ -0,0 +1,5 @@
+data "lightdash_api_status" "this" {}
+
+output "rate_limit_remaining" {
+  value = data.lightdash_api_status.this.rate_limit_remaining
+}
@@ -0,0 +1,17 @@
+# All the saved charts of a project
+data "lightdash_charts" "all" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+# Only the saved charts in a space
+data "lightdash_charts" "finance" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = "yyyyyyyy-yyyyyyyyyy-yyyyyyyyy"
+}
+
+output "chart_index" {
+  value = [
+    for chart in data.lightdash_charts.all.charts :
+    "${chart.name} (${coalesce(chart.chart_type, "unknown")})"
+  ]
+}
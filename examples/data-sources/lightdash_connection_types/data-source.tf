@@ -0,0 +1,11 @@
+data "lightdash_connection_types" "this" {}
+
+# Fail fast when the provider doesn't support Snowflake
+output "supports_snowflake" {
+  value = contains(data.lightdash_connection_types.this.supported_warehouse_types, "snowflake")
+}
+
+# Fail fast when the server doesn't have local dbt enabled
+output "supports_local_dbt" {
+  value = contains(data.lightdash_connection_types.this.supported_dbt_types, "dbt")
+}
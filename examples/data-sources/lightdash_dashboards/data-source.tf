@@ -0,0 +1,18 @@
+# All the dashboards of a project
+data "lightdash_dashboards" "all" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+# Only the dashboards in a space
+data "lightdash_dashboards" "finance" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = "yyyyyyyy-yyyyyyyyyy-yyyyyyyyy"
+}
+
+# Dashboard names are not unique, so the links are keyed by UUID
+output "dashboard_links" {
+  value = {
+    for dashboard in data.lightdash_dashboards.all.dashboards :
+    dashboard.dashboard_uuid => "https://app.lightdash.cloud/projects/xxxxxxxx-xxxxxxxxxx-xxxxxxxxx/dashboards/${dashboard.dashboard_uuid}"
+  }
+}
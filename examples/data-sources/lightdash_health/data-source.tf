@@ -0,0 +1,13 @@
+data "lightdash_health" "this" {}
+
+output "lightdash_version" {
+  value = data.lightdash_health.this.version
+}
+
+# Fail the plan when the instance isn't the expected version
+check "lightdash_version" {
+  assert {
+    condition     = startswith(data.lightdash_health.this.version, "0.")
+    error_message = "Unexpected Lightdash version: ${data.lightdash_health.this.version}"
+  }
+}
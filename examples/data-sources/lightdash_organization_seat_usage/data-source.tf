@@ -0,0 +1,15 @@
+data "lightdash_organization_seat_usage" "this" {
+  seat_limit = 50
+}
+
+output "seats_remaining" {
+  value = data.lightdash_organization_seat_usage.this.seats_remaining
+}
+
+# Warn when less than 5 seats are left
+check "lightdash_seats" {
+  assert {
+    condition     = data.lightdash_organization_seat_usage.this.seats_remaining >= 5
+    error_message = "Only ${data.lightdash_organization_seat_usage.this.seats_remaining} Lightdash seats are left."
+  }
+}
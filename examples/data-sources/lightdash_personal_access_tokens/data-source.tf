@@ -5,3 +5,11 @@ data "lightdash_personal_access_tokens" "all" {}
 output "tokens" {
   value = data.lightdash_personal_access_tokens.all.tokens
 }
+
+# List the tokens that have been unused for 90 days or more
+output "stale_token_uuids" {
+  value = [
+    for token in data.lightdash_personal_access_tokens.all.tokens : token.token_uuid
+    if coalesce(token.days_since_last_used, 0) >= 90
+  ]
+}
@@ -0,0 +1,11 @@
+data "lightdash_project_data_catalog" "revenue" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  search       = "revenue"
+}
+
+output "revenue_metrics" {
+  value = [
+    for field in data.lightdash_project_data_catalog.revenue.fields : "${field.table}.${field.name}"
+    if field.field_type == "metric"
+  ]
+}
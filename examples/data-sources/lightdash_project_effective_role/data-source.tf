@@ -0,0 +1,9 @@
+data "lightdash_project_effective_role" "analyst" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  user_uuid    = "yyyyyyyy-yyyyyyyyyy-yyyyyyyyy"
+}
+
+# e.g. "editor (group)"
+output "analyst_access" {
+  value = data.lightdash_project_effective_role.analyst.role == null ? "no access" : "${data.lightdash_project_effective_role.analyst.role} (${data.lightdash_project_effective_role.analyst.source})"
+}
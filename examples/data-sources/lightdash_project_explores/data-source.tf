@@ -0,0 +1,7 @@
+data "lightdash_project_explores" "analytics" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+output "explore_tables" {
+  value = [for explore in data.lightdash_project_explores.analytics.explores : "${explore.database}.${explore.schema}.${explore.name}"]
+}
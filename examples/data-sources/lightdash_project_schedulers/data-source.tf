@@ -0,0 +1,12 @@
+data "lightdash_project_schedulers" "analytics" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+# The schedulers sending data by email, e.g. to review the recipients
+output "email_deliveries" {
+  value = [
+    for scheduler in data.lightdash_project_schedulers.analytics.schedulers :
+    "${scheduler.name} (${scheduler.format}, ${scheduler.cron})"
+    if scheduler.target_type == "email"
+  ]
+}
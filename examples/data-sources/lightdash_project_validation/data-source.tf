@@ -0,0 +1,10 @@
+data "lightdash_project_validation" "test" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+check "no_validation_errors" {
+  assert {
+    condition     = data.lightdash_project_validation.test.validation_error_count == 0
+    error_message = "The Lightdash project has ${data.lightdash_project_validation.test.validation_error_count} validation errors."
+  }
+}
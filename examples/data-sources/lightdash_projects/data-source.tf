@@ -1,3 +1,10 @@
 data "lightdash_projects" "test" {
   organization_uuid = "xxxxx-xxxxxx-xxxx"
 }
+
+# Import all the existing projects
+import {
+  for_each = { for project in data.lightdash_projects.test.projects : project.project_uuid => project }
+  to       = lightdash_project.imported[each.key]
+  id       = each.value.import_id
+}
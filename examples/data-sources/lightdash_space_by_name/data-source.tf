@@ -0,0 +1,8 @@
+data "lightdash_space_by_name" "finance" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name         = "Finance"
+}
+
+output "finance_space_uuid" {
+  value = data.lightdash_space_by_name.finance.space_uuid
+}
@@ -0,0 +1,11 @@
+ephemeral "lightdash_warehouse_credentials_check" "bigquery" {
+  organization_warehouse_credentials_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
+
+# Fail the run when the credentials can't connect to the warehouse
+check "warehouse_credentials" {
+  assert {
+    condition     = ephemeral.lightdash_warehouse_credentials_check.bigquery.success
+    error_message = "The warehouse credentials can't connect: ${ephemeral.lightdash_warehouse_credentials_check.bigquery.message}"
+  }
+}
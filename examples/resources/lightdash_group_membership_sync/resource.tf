@@ -0,0 +1,16 @@
+resource "lightdash_group_membership_sync" "analysts" {
+  group_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+
+  member_emails = [
+    "alice@example.com",
+    "bob@example.com",
+  ]
+  member_uuids = [
+    "xxxxxxxxxxx-xxxxxxxxxxxx-xxxxxxxxxx",
+  ]
+
+  # Members added by hand in Lightdash are kept in the group
+  unmanaged_members = [
+    "admin@example.com",
+  ]
+}
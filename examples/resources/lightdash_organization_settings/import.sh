@@ -0,0 +1,2 @@
+# The organization settings can be imported by specifying the resource identifier.
+terraform import lightdash_organization_settings.this "organizations/${organization_uuid}/settings"
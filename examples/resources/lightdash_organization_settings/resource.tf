@@ -0,0 +1,13 @@
+resource "lightdash_organization_settings" "this" {
+  name                 = "Example Inc."
+  default_project_uuid = lightdash_project.analytics.project_uuid
+
+  chart_colors = [
+    "#5470c6",
+    "#fc8452",
+    "#9a60b4",
+  ]
+
+  # The palette must exist in the appearance settings of the organization
+  active_palette_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
@@ -14,3 +14,14 @@ output "ci_token_value" {
   value     = lightdash_personal_access_token.ci_token.token
   sensitive = true
 }
+
+# Fail the creation if a token with the same description already exists
+resource "lightdash_personal_access_token" "automation_token" {
+  description                = "Automation token"
+  require_unique_description = true
+}
+
+# Expose when the token was last used, e.g. to alert on unused credentials
+output "ci_token_last_used_at" {
+  value = lightdash_personal_access_token.ci_token.last_used_at
+}
@@ -0,0 +1,7 @@
+# Projects can be imported by specifying the resource identifier.
+terraform import lightdash_project.example "organizations/${organization_uuid}/projects/${project_uuid}"
+
+# The API never returns the connection settings, so `dbt_connection` and `warehouse_connection`
+# (including the sensitive `dbt_connection.personal_access_token` and `warehouse_connection.keyfile_contents`)
+# are left empty by the import. They are adopted from the configuration on the next apply without
+# changing the project in Lightdash.
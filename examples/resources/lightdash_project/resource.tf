@@ -27,6 +27,9 @@ resource "lightdash_project" "analytics" {
 
   # Reference warehouse credentials
   organization_warehouse_credentials_uuid = lightdash_warehouse_credentials.bigquery.organization_warehouse_uuid
+
+  # Only finish the creation once the dbt project has compiled
+  wait_for_compile = true
 }
 
 # Create a preview project
@@ -34,7 +37,8 @@ resource "lightdash_project" "preview" {
   organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
   name              = "Preview Project"
   type              = "PREVIEW"
-  dbt_version       = "v1.8"
+  # "latest" is resolved to the newest supported dbt version at creation time
+  dbt_version = "latest"
 
   dbt_connection = {
     type                  = "github"
@@ -49,6 +53,39 @@ resource "lightdash_project" "preview" {
   # Preview project references upstream project
   upstream_project_uuid                   = lightdash_project.analytics.project_uuid
   organization_warehouse_credentials_uuid = lightdash_warehouse_credentials.bigquery.organization_warehouse_uuid
+
+  # Only copy the selected content from the upstream project
+  content_copy_selector = {
+    space_uuids = ["xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"]
+  }
+
+  # Copying content can take a while on large projects
+  timeouts = {
+    create = "40m"
+    update = "10m"
+  }
+}
+
+# Preview project started without the charts and dashboards of the upstream project
+resource "lightdash_project" "preview_empty" {
+  organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name              = "Empty Preview Project"
+  type              = "PREVIEW"
+  dbt_version       = "v1.10"
+
+  dbt_connection = {
+    type                 = "github"
+    authorization_method = "installation_id"
+    repository           = "my-org/dbt-project"
+    branch               = "feature/new-metrics"
+    project_sub_path     = "/"
+  }
+
+  upstream_project_uuid = lightdash_project.analytics.project_uuid
+  copy_content          = false
+
+  # Reuse the warehouse connection of the upstream project
+  copy_warehouse_connection = true
 }
 
 # Alternative: Create a project with inline warehouse connection
@@ -83,5 +120,84 @@ resource "lightdash_project" "analytics_inline" {
     priority             = "interactive"
     retries              = 3
     start_of_week        = 1
+    threads              = 8
   }
 }
+
+# Project shell without a git repository
+# The dbt content is deployed separately, e.g. with `lightdash deploy`
+resource "lightdash_project" "analytics_deployed" {
+  organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name              = "Analytics Project deployed from CI"
+  type              = "DEFAULT"
+  dbt_version       = "v1.10"
+
+  dbt_connection = {
+    type = "none"
+  }
+
+  organization_warehouse_credentials_uuid = lightdash_warehouse_credentials.bigquery.organization_warehouse_uuid
+
+  # Raise the row limit of the queries for the analysts
+  query_row_limit = 10000
+
+  # Only detect the deletion of the project, not the changes made in the UI
+  read_refresh = false
+}
+
+# Clone of an existing project, which keeps its own connections
+# Lightdash records the source project as upstream project, see linked_upstream_project_uuid
+resource "lightdash_project" "analytics_clone" {
+  organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name              = "Analytics Project (clone)"
+  type              = "DEFAULT"
+  dbt_version       = "v1.10"
+
+  dbt_connection = {
+    type                 = "github"
+    authorization_method = "installation_id"
+    repository           = "my-org/dbt-project"
+    branch               = "main"
+    project_sub_path     = "/"
+  }
+
+  clone_from_project_uuid                 = lightdash_project.analytics.project_uuid
+  organization_warehouse_credentials_uuid = lightdash_warehouse_credentials.bigquery.organization_warehouse_uuid
+}
+
+# Organization warehouse credentials referenced by name
+# The name is resolved to organization_warehouse_credentials_uuid when the project is created
+resource "lightdash_project" "analytics_by_credentials_name" {
+  organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name              = "Analytics Project (shared credentials)"
+  type              = "DEFAULT"
+  dbt_version       = "v1.10"
+
+  dbt_connection = {
+    type = "none"
+  }
+
+  organization_warehouse_credentials_name = "BigQuery Production"
+}
+
+# dbt Cloud project
+# The environment is read back, so repointing it in the Lightdash UI shows up as drift
+resource "lightdash_project" "analytics_dbt_cloud" {
+  organization_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name              = "Analytics Project (dbt Cloud)"
+  type              = "DEFAULT"
+  dbt_version       = "v1.10"
+
+  dbt_connection = {
+    type           = "dbt_cloud_ide"
+    environment_id = "123456"
+    api_key        = var.dbt_cloud_service_token
+  }
+
+  organization_warehouse_credentials_name = "BigQuery Production"
+}
+
+# Expose the import ID, e.g. to import the project in another workspace
+output "analytics_import_id" {
+  value = lightdash_project.analytics.import_id
+}
@@ -0,0 +1,3 @@
+# The pinned items order can be imported by specifying the resource identifier.
+# The current order of all the pinned items is imported.
+terraform import lightdash_project_pinned_items_order.example "projects/${project_uuid}/pinned_items_order"
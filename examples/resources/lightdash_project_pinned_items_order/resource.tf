@@ -0,0 +1,9 @@
+resource "lightdash_project_pinned_items_order" "homepage" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+
+  item_uuids = [
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx", # KPI dashboard
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx", # Marketing space
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx", # Weekly active users chart
+  ]
+}
@@ -0,0 +1,2 @@
+# The refresh schedule of a project can be imported by specifying the resource identifier.
+terraform import lightdash_project_refresh_schedule.example "projects/${project_uuid}/refresh_schedule"
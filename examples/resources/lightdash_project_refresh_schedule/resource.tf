@@ -0,0 +1,5 @@
+# Refresh the dbt project every morning at 6:00
+resource "lightdash_project_refresh_schedule" "analytics" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  cron         = "0 6 * * *"
+}
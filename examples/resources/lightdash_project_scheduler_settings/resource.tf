@@ -3,4 +3,5 @@ resource "lightdash_project_scheduler_settings" "example" {
   project_uuid      = "proj-1234567890"
 
   scheduler_timezone = "Asia/Tokyo"
+  scheduler_enabled  = true
 }
@@ -0,0 +1,3 @@
+# The semantic layer of a project can be imported by specifying the resource identifier.
+# The service token is not returned by the API, so it must be set in the configuration.
+terraform import lightdash_project_semantic_layer.example "projects/${project_uuid}/semantic_layer"
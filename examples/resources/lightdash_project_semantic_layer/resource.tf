@@ -0,0 +1,11 @@
+variable "dbt_cloud_service_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "lightdash_project_semantic_layer" "analytics" {
+  project_uuid   = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  enabled        = true
+  environment_id = "123456"
+  token          = var.dbt_cloud_service_token
+}
@@ -3,9 +3,9 @@
 ##########################################################################
 resource "lightdash_space" "test_public" {
   project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
-  name         = "zzz_test_private_space"
-  // The visibility is private by default.
-  is_private = true
+  name         = "zzz_test_public_space"
+  // Content created in a public space is visible to the whole project.
+  is_private = false
 
   deletion_protection = false
 }
@@ -0,0 +1,3 @@
+# The content of a space can be imported by specifying the resource identifier.
+# All the charts and dashboards currently stored in the space are imported.
+terraform import lightdash_space_content.example "projects/${project_uuid}/spaces/${space_uuid}/content"
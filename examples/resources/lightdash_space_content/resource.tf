@@ -0,0 +1,11 @@
+resource "lightdash_space_content" "marketing" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = lightdash_space.marketing.space_uuid
+
+  chart_uuids = [
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx",
+  ]
+  dashboard_uuids = [
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx",
+  ]
+}
@@ -15,10 +15,29 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxIdleConns is the default maximum number of idle connections across all hosts.
+	DefaultMaxIdleConns = 100
+	// DefaultMaxIdleConnsPerHost is the default maximum number of idle connections kept to the Lightdash host.
+	DefaultMaxIdleConnsPerHost = 10
+	// DefaultIdleConnTimeout is the default time an idle connection is kept open.
+	DefaultIdleConnTimeout = 90 * time.Second
+	// DefaultMaxResponseSize is the default maximum size of a response body, which protects against huge catalogs.
+	DefaultMaxResponseSize int64 = 64 << 20
+	// DefaultListTimeout bounds list operations, which can enumerate many pages in big organizations.
+	DefaultListTimeout = 5 * time.Minute
 )
 
 type Client struct {
@@ -26,6 +45,35 @@ type Client struct {
 	HostUrl    string
 	Token      string
 	Semaphore  chan struct{}
+	// CreateProjectMaxJitter is the upper bound of the random delay applied before creating a project.
+	// It spreads out bursts of concurrent project creations. Zero disables the jitter.
+	CreateProjectMaxJitter time.Duration
+	// DefaultWarehouseCredentialsUUID is used by projects that configure neither inline nor organization warehouse credentials.
+	DefaultWarehouseCredentialsUUID string
+	// ExtraHeaders are added to every request, e.g. for an authentication proxy in front of Lightdash.
+	// Their values are masked in the logs.
+	ExtraHeaders map[string]string
+	// MaxResponseSize is the maximum size in bytes of a response body. Larger responses fail instead of being buffered.
+	MaxResponseSize int64
+	// MaxRetries is the maximum number of retries of a request after a transient error. Zero disables the retries.
+	MaxRetries int
+	// MaxNetworkRetries is the maximum number of retries of a request that failed before any response, e.g. when the
+	// connection is refused while Lightdash restarts. It is counted apart from MaxRetries. Zero disables the retries.
+	MaxNetworkRetries int
+	// LogRetries logs each retry as a warning, so that an unstable Lightdash API is visible without debug logs
+	LogRetries bool
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// retryBudget is shared by the clients derived with WithHost, so that it bounds the retries of the whole apply
+	retryBudget *retryBudget
+
+	// oauth authenticates the requests with OAuth access tokens instead of the token when it is set
+	oauth *oauthTokenSource
+
+	rateLimitMutex      sync.Mutex
+	lastRateLimitStatus RateLimitStatus
 }
 
 func NewClient(host, token *string, maxConcurrentRequests *int64) (*Client, error) {
@@ -35,8 +83,17 @@ func NewClient(host, token *string, maxConcurrentRequests *int64) (*Client, erro
 	}
 
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		Semaphore:  make(chan struct{}, maxRequests),
+		HTTPClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: newTransport(DefaultMaxIdleConns, DefaultMaxIdleConnsPerHost, DefaultIdleConnTimeout),
+		},
+		Semaphore:         make(chan struct{}, maxRequests),
+		MaxResponseSize:   DefaultMaxResponseSize,
+		MaxRetries:        DefaultMaxRetries,
+		MaxNetworkRetries: DefaultMaxNetworkRetries,
+		LogRetries:        true,
+		RetryWaitMin:      DefaultRetryWaitMin,
+		RetryWaitMax:      DefaultRetryWaitMax,
 	}
 
 	if host != nil {
@@ -56,41 +113,330 @@ func NewClient(host, token *string, maxConcurrentRequests *int64) (*Client, erro
 	return &c, nil
 }
 
+// NewClientWithHTTPClient creates a client sending its requests with the given HTTP client,
+// e.g. the client of an httptest.Server in unit tests or a client with a custom transport.
+func NewClientWithHTTPClient(host, token *string, maxConcurrentRequests *int64, httpClient *http.Client) (*Client, error) {
+	if httpClient == nil {
+		return nil, fmt.Errorf("the HTTP client must not be nil")
+	}
+	c, err := NewClient(host, token, maxConcurrentRequests)
+	if err != nil {
+		return nil, err
+	}
+	c.HTTPClient = httpClient
+	return c, nil
+}
+
+// WithHost returns a client targeting another Lightdash host, authenticated with the given token.
+// It shares the HTTP client, the concurrency limit and the retry settings with the original client.
+// The credentials of the original client are never sent to the other host, so neither its token,
+// its OAuth client credentials, its extra headers nor its default warehouse credentials are kept.
+func (c *Client) WithHost(host string, token string) *Client {
+	return &Client{
+		HTTPClient:             c.HTTPClient,
+		HostUrl:                host,
+		Token:                  token,
+		Semaphore:              c.Semaphore,
+		CreateProjectMaxJitter: c.CreateProjectMaxJitter,
+		MaxResponseSize:        c.MaxResponseSize,
+		MaxRetries:             c.MaxRetries,
+		MaxNetworkRetries:      c.MaxNetworkRetries,
+		LogRetries:             c.LogRetries,
+		RetryWaitMin:           c.RetryWaitMin,
+		RetryWaitMax:           c.RetryWaitMax,
+		retryBudget:            c.retryBudget,
+	}
+}
+
+// SetConnectionPool replaces the transport to keep the given number of idle connections alive.
+// Reusing connections avoids opening a new connection for every request of large applies.
+func (c *Client) SetConnectionPool(maxIdleConns int, maxIdleConnsPerHost int, idleConnTimeout time.Duration) {
+	c.HTTPClient.Transport = newTransport(maxIdleConns, maxIdleConnsPerHost, idleConnTimeout)
+}
+
+// WithListTimeout returns a context bounding a list operation by DefaultListTimeout,
+// unless the context already has a deadline.
+func WithListTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, DefaultListTimeout)
+}
+
+// requestTimeoutContextKey is the context key of the timeout of each request, which replaces the client timeout
+type requestTimeoutContextKey struct{}
+
+// WithRequestTimeout returns a context whose requests may each take up to the timeout instead of the client timeout,
+// e.g. for a project creation that copies content. The deadline of the context still bounds the requests.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutContextKey{}, timeout)
+}
+
+func requestTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(requestTimeoutContextKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// logContext returns the context of the request with the secrets masked in the logs
+func (c *Client) logContext(req *http.Request) context.Context {
+	secrets := []string{}
+	if c.Token != "" {
+		secrets = append(secrets, c.Token)
+	}
+	for _, value := range c.ExtraHeaders {
+		if value != "" {
+			secrets = append(secrets, value)
+		}
+	}
+	if c.oauth != nil {
+		secrets = append(secrets, c.oauth.secrets()...)
+	}
+	secrets = append(secrets, secretsFromContext(req.Context())...)
+	ctx := tflog.MaskMessageStrings(req.Context(), secrets...)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+	if resource := resourceFromContext(req.Context()); resource != "" {
+		ctx = tflog.SetField(ctx, "lightdash_resource", resource)
+	}
+	return ctx
+}
+
+// resourceContextKey is the context key of the resource sending a request
+type resourceContextKey struct{}
+
+// WithResource returns a context whose requests are logged with the type and the name of the resource sending them,
+// e.g. `lightdash_project (Analytics)`, to correlate the API calls with the resources of busy applies.
+// The name is the one of the Lightdash object, since providers don't know the address of the resource in the configuration.
+// Only the requests of the API functions taking a context are labelled.
+func WithResource(ctx context.Context, resourceType string, name string) context.Context {
+	resource := resourceType
+	if name != "" {
+		resource = fmt.Sprintf("%s (%s)", resourceType, name)
+	}
+	return context.WithValue(ctx, resourceContextKey{}, resource)
+}
+
+func resourceFromContext(ctx context.Context) string {
+	resource, _ := ctx.Value(resourceContextKey{}).(string)
+	return resource
+}
+
+// requestLogMessage describes a request in the logs, e.g. `POST /api/v1/org/projects [lightdash_project (Analytics)]`
+func requestLogMessage(req *http.Request) string {
+	message := fmt.Sprintf("%s %s", req.Method, req.URL.Path)
+	if resource := resourceFromContext(req.Context()); resource != "" {
+		message = fmt.Sprintf("%s [%s]", message, resource)
+	}
+	return message
+}
+
+// secretsContextKey is the context key of the secrets of a request
+type secretsContextKey struct{}
+
+// WithSecrets returns a context whose requests mask the secrets in the logs, e.g. the sensitive values of a request body
+func WithSecrets(ctx context.Context, secrets ...string) context.Context {
+	return context.WithValue(ctx, secretsContextKey{}, append(secretsFromContext(ctx), secrets...))
+}
+
+func secretsFromContext(ctx context.Context) []string {
+	secrets, _ := ctx.Value(secretsContextKey{}).([]string)
+	return secrets
+}
+
+// maskSecrets replaces the secrets in a text, e.g. a request body included in an error
+func maskSecrets(text string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, "***")
+		}
+	}
+	return text
+}
+
+// sensitiveBodyKeys are the JSON keys whose values are redacted by SanitizeBody, compared case-insensitively
+var sensitiveBodyKeys = map[string]bool{
+	"keyfilecontents":       true,
+	"personal_access_token": true,
+	"personalaccesstoken":   true,
+	"api_key":               true,
+	"apikey":                true,
+	"token":                 true,
+	"password":              true,
+	"privatekey":            true,
+	"privatekeypass":        true,
+	"sshprivatekey":         true,
+	"clientsecret":          true,
+	"secret":                true,
+}
+
+// SanitizeBody redacts the values of the sensitive keys of a JSON body, so that it can be included in an error.
+// A body that isn't valid JSON is omitted, since its secrets can't be located.
+func SanitizeBody(body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "<omitted non-JSON body>"
+	}
+	sanitized, err := json.Marshal(redactSensitiveValues(decoded))
+	if err != nil {
+		return "<omitted body>"
+	}
+	return string(sanitized)
+}
+
+// redactSensitiveValues replaces the values of the sensitive keys at any depth of a decoded JSON value
+func redactSensitiveValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if sensitiveBodyKeys[strings.ToLower(key)] && nested != nil {
+				v[key] = "***"
+				continue
+			}
+			v[key] = redactSensitiveValues(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactSensitiveValues(nested)
+		}
+	}
+	return value
+}
+
+func newTransport(maxIdleConns int, maxIdleConnsPerHost int, idleConnTimeout time.Duration) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConns
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = idleConnTimeout
+	return transport
+}
+
 func (c *Client) DoRequest(req *http.Request) ([]byte, error) {
+	body, _, err := c.doRequest(req)
+	return body, err
+}
+
+// doRequest sends the request with retries, and returns the body and the headers of the successful response
+func (c *Client) doRequest(req *http.Request) ([]byte, http.Header, error) {
+	c.setRequestHeaders(req, "application/json")
+
+	// Each attempt is bounded by the client timeout, unless the context allows a longer request.
+	// The deadline of the context applies too, so the earliest of both stops the attempt.
+	httpClient := c.HTTPClient
+	if timeout, ok := requestTimeoutFromContext(req.Context()); ok && httpClient.Timeout > 0 {
+		withTimeout := *httpClient
+		withTimeout.Timeout = timeout
+		httpClient = &withTimeout
+	}
+
+	// The retries after a response and the retries after a network error are counted apart
+	statusRetries, networkRetries := 0, 0
+	for attempt := 0; ; attempt++ {
+		body, res, err := c.doRequestOnce(httpClient, req)
+		if err != nil {
+			if networkRetries >= c.MaxNetworkRetries || !c.canRetryNetworkError(req, err) {
+				return nil, nil, err
+			}
+			delay := c.retryDelay(networkRetries, nil)
+			networkRetries++
+			c.logRetry(req, attempt+1, delay, err.Error())
+			if !c.reserveRetry(delay) {
+				return nil, nil, fmt.Errorf("retry budget of the client is exhausted, so %s %s is not retried: %w", req.Method, req.URL.Path, err)
+			}
+			if err := WaitForRetry(req.Context(), delay); err != nil {
+				return nil, nil, fmt.Errorf("retry of %s %s cancelled after a network error: %w", req.Method, req.URL.Path, err)
+			}
+			if err := rewindBody(req); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		tflog.Debug(c.logContext(req), requestLogMessage(req), map[string]interface{}{
+			"status_code": res.StatusCode,
+			"attempt":     attempt + 1,
+		})
+		if isSuccessful(res.StatusCode) {
+			return body, res.Header, nil
+		}
+
+		// Permanent errors fail right away, and transient errors once the retries are exhausted
+		if statusRetries >= c.MaxRetries || !c.canRetry(req, res.StatusCode) {
+			return nil, nil, &StatusError{StatusCode: res.StatusCode, Body: body}
+		}
+		delay := c.retryDelay(statusRetries, res.Header)
+		statusRetries++
+		c.logRetry(req, attempt+1, delay, fmt.Sprintf("status code %d", res.StatusCode))
+		if !c.reserveRetry(delay) {
+			return nil, nil, fmt.Errorf("retry budget of the client is exhausted, so %s %s is not retried: %w", req.Method, req.URL.Path, &StatusError{StatusCode: res.StatusCode, Body: body})
+		}
+		if err := WaitForRetry(req.Context(), delay); err != nil {
+			return nil, nil, fmt.Errorf("retry of %s %s cancelled after status code %d: %w", req.Method, req.URL.Path, res.StatusCode, err)
+		}
+		if err := rewindBody(req); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// setRequestHeaders sets the content headers of a request, then the extra headers,
+// so that the requests sent through an authentication proxy are all accepted by it.
+func (c *Client) setRequestHeaders(req *http.Request, contentType string) {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	for name, value := range c.ExtraHeaders {
+		req.Header.Set(name, value)
+	}
+}
+
+// doRequestOnce sends the request once and reads the response body.
+// The concurrency limit only applies while the request is in flight, not while waiting for a retry.
+func (c *Client) doRequestOnce(httpClient *http.Client, req *http.Request) ([]byte, *http.Response, error) {
 	if c.Semaphore != nil {
 		c.Semaphore <- struct{}{}
 		defer func() { <-c.Semaphore }()
 	}
 
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("ApiKey %s", c.Token))
+	authorization, err := c.authorizationHeader(req.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", authorization)
 
-	res, err := c.HTTPClient.Do(req) // #nosec G704 -- URLs are built from the configured Lightdash host and documented API paths.
+	res, err := httpClient.Do(req) // #nosec G704 -- URLs are built from the configured Lightdash host and documented API paths.
 	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+		return nil, nil, fmt.Errorf("error making request: %w", err)
 	}
 	defer res.Body.Close() // #nosec G307
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+	// Keep track of the rate limit to help tuning the concurrency
+	if rateLimit := parseRateLimitHeaders(res.Header); !rateLimit.IsEmpty() {
+		c.recordRateLimitStatus(rateLimit)
+		tflog.Debug(c.logContext(req), "Lightdash API rate limit", rateLimit.logFields(req, res.StatusCode))
 	}
 
-	// Successful response codes
-	if res.StatusCode == http.StatusOK ||
-		res.StatusCode == http.StatusCreated ||
-		res.StatusCode == http.StatusAccepted ||
-		res.StatusCode == http.StatusNonAuthoritativeInfo ||
-		res.StatusCode == http.StatusNoContent ||
-		res.StatusCode == http.StatusResetContent ||
-		res.StatusCode == http.StatusPartialContent ||
-		res.StatusCode == http.StatusMultiStatus ||
-		res.StatusCode == http.StatusAlreadyReported ||
-		res.StatusCode == http.StatusIMUsed {
-		return body, nil
+	// Read one more byte than the limit to tell a response of exactly the limit from a larger one
+	maxResponseSize := c.MaxResponseSize
+	if maxResponseSize <= 0 {
+		maxResponseSize = DefaultMaxResponseSize
 	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading response body: %v", err)
+	}
+	if int64(len(body)) > maxResponseSize {
+		return nil, nil, fmt.Errorf("response body of %s %s exceeds the maximum response size of %d bytes", req.Method, req.URL.Path, maxResponseSize)
+	}
+	return body, res, nil
+}
 
-	// Error response codes
-	return nil, fmt.Errorf("unexpected status code: %d, body: %s", res.StatusCode, body)
+// isSuccessful reports whether the status code is a successful response code
+func isSuccessful(statusCode int) bool {
+	return statusCode == http.StatusOK ||
+		statusCode == http.StatusCreated ||
+		statusCode == http.StatusAccepted ||
+		statusCode == http.StatusNonAuthoritativeInfo ||
+		statusCode == http.StatusNoContent ||
+		statusCode == http.StatusResetContent ||
+		statusCode == http.StatusPartialContent ||
+		statusCode == http.StatusMultiStatus ||
+		statusCode == http.StatusAlreadyReported ||
+		statusCode == http.StatusIMUsed
 }
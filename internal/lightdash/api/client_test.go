@@ -15,7 +15,11 @@
 package api
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -102,3 +106,228 @@ func TestNewClient(t *testing.T) {
 		t.Errorf("Expected empty Token, got: %s", client.Token)
 	}
 }
+
+func TestSetConnectionPool(t *testing.T) {
+	host := "example.com"
+	client, err := NewClient(&host, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+
+	// The default connection pool
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got: %T", client.HTTPClient.Transport)
+	}
+	if transport.MaxIdleConns != DefaultMaxIdleConns {
+		t.Errorf("Expected MaxIdleConns: %d, got: %d", DefaultMaxIdleConns, transport.MaxIdleConns)
+	}
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+		t.Errorf("Expected MaxIdleConnsPerHost: %d, got: %d", DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultIdleConnTimeout {
+		t.Errorf("Expected IdleConnTimeout: %s, got: %s", DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	}
+
+	// A custom connection pool
+	client.SetConnectionPool(50, 20, 30*time.Second)
+	transport = client.HTTPClient.Transport.(*http.Transport)
+	if transport.MaxIdleConns != 50 {
+		t.Errorf("Expected MaxIdleConns: 50, got: %d", transport.MaxIdleConns)
+	}
+	if transport.MaxIdleConnsPerHost != 20 {
+		t.Errorf("Expected MaxIdleConnsPerHost: 20, got: %d", transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != 30*time.Second {
+		t.Errorf("Expected IdleConnTimeout: 30s, got: %s", transport.IdleConnTimeout)
+	}
+}
+
+func TestWithHost(t *testing.T) {
+	host := "https://app.lightdash.cloud"
+	token := "abc123"
+	client, err := NewClient(&host, &token, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.ExtraHeaders = map[string]string{"X-Proxy-Auth": "secret"}
+	client.DefaultWarehouseCredentialsUUID = "credentials-uuid"
+	client.SetOAuthClientCredentials("client-id", "client-secret")
+
+	other := client.WithHost("https://lightdash.example.com", "def456")
+	if other.HostUrl != "https://lightdash.example.com" {
+		t.Errorf("Expected HostUrl: https://lightdash.example.com, got: %s", other.HostUrl)
+	}
+	if client.HostUrl != host {
+		t.Errorf("Expected the original HostUrl to be unchanged, got: %s", client.HostUrl)
+	}
+	if other.HTTPClient != client.HTTPClient || other.Semaphore != client.Semaphore {
+		t.Error("Expected the HTTP client and the semaphore to be shared")
+	}
+	// The credentials of the original client are not sent to the other host
+	if other.Token != "def456" {
+		t.Errorf("Expected Token: def456, got: %s", other.Token)
+	}
+	if other.oauth != nil || other.ExtraHeaders != nil || other.DefaultWarehouseCredentialsUUID != "" {
+		t.Errorf("Expected the OAuth credentials, the extra headers and the default warehouse credentials to be dropped, got: %v, %v, %q", other.oauth, other.ExtraHeaders, other.DefaultWarehouseCredentialsUUID)
+	}
+}
+
+func TestWithListTimeout(t *testing.T) {
+	// A context without deadline is bounded by the default list timeout
+	ctx, cancel := WithListTimeout(context.Background())
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > DefaultListTimeout {
+		t.Errorf("Expected a deadline within %s, got: %v", DefaultListTimeout, deadline)
+	}
+
+	// An existing deadline is kept
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer parentCancel()
+	ctx, cancel = WithListTimeout(parent)
+	defer cancel()
+	deadline, _ = ctx.Deadline()
+	parentDeadline, _ := parent.Deadline()
+	if !deadline.Equal(parentDeadline) {
+		t.Errorf("Expected deadline %v, got: %v", parentDeadline, deadline)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	httpClient.Timeout = 50 * time.Millisecond
+	client := &Client{HTTPClient: httpClient, HostUrl: server.URL}
+
+	// The client timeout still bounds each request of a list operation with a long deadline
+	ctx, cancel := WithListTimeout(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/v1/org/users", nil)
+	if _, err := client.DoRequest(req); err == nil {
+		t.Error("Expected the client timeout to stop the request")
+	}
+
+	// A longer request timeout replaces the client timeout
+	req, _ = http.NewRequestWithContext(WithRequestTimeout(ctx, 5*time.Second), "GET", server.URL+"/api/v1/org/users", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error with a longer request timeout: %v", err)
+	}
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "ApiKey abc123" {
+			t.Errorf("Expected Authorization header 'ApiKey abc123', got: %s", r.Header.Get("Authorization"))
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	token := "abc123"
+	client, err := NewClientWithHTTPClient(&server.URL, &token, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	if client.HTTPClient != server.Client() {
+		t.Error("Expected the injected HTTPClient")
+	}
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+
+	if _, err := NewClientWithHTTPClient(&server.URL, &token, nil, nil); err == nil {
+		t.Error("Expected an error for a nil HTTP client")
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	if client.MaxResponseSize != DefaultMaxResponseSize {
+		t.Errorf("Expected default MaxResponseSize: %d, got: %d", DefaultMaxResponseSize, client.MaxResponseSize)
+	}
+
+	// A response of exactly the limit is accepted
+	client.MaxResponseSize = int64(len(`{"status":"ok"}`))
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+
+	// A larger response fails
+	client.MaxResponseSize--
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err == nil {
+		t.Error("Expected an error for a response larger than the limit")
+	}
+}
+
+func TestRequestLogMessage(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://app.lightdash.cloud/api/v1/org/projects", nil)
+	if message := requestLogMessage(req); message != "POST /api/v1/org/projects" {
+		t.Errorf("Expected message without resource, got: %s", message)
+	}
+
+	ctx := WithResource(context.Background(), "lightdash_project", "Analytics")
+	req, _ = http.NewRequestWithContext(ctx, "POST", "https://app.lightdash.cloud/api/v1/org/projects", nil)
+	if message := requestLogMessage(req); message != "POST /api/v1/org/projects [lightdash_project (Analytics)]" {
+		t.Errorf("Expected message with resource, got: %s", message)
+	}
+
+	// The resource type alone is used when the resource has no name yet
+	ctx = WithResource(context.Background(), "lightdash_project", "")
+	if resource := resourceFromContext(ctx); resource != "lightdash_project" {
+		t.Errorf("Expected resource type only, got: %s", resource)
+	}
+}
+
+func TestSanitizeBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "nested secrets",
+			body:     `{"name":"analytics","warehouseConnection":{"type":"bigquery","keyfileContents":{"private_key":"-----BEGIN"}},"dbtConnection":{"personal_access_token":"ghp_secret","repository":"org/repo"}}`,
+			expected: `{"dbtConnection":{"personal_access_token":"***","repository":"org/repo"},"name":"analytics","warehouseConnection":{"keyfileContents":"***","type":"bigquery"}}`,
+		},
+		{
+			name:     "secrets in a list",
+			body:     `[{"Token":"abc"},{"description":"ci"}]`,
+			expected: `[{"Token":"***"},{"description":"ci"}]`,
+		},
+		{
+			name:     "null secret",
+			body:     `{"password":null}`,
+			expected: `{"password":null}`,
+		},
+		{
+			name:     "not JSON",
+			body:     `password=s3cr3t`,
+			expected: `<omitted non-JSON body>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeBody([]byte(tt.body)); got != tt.expected {
+				t.Errorf("SanitizeBody() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -45,14 +45,14 @@ func (c *Client) CreatePersonalAccessTokenV1(request *models.CreatePersonalAcces
 	// Do the request
 	body, err := c.DoRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("error performing POST request for personal access token: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("error performing POST request for personal access token: %v, body: %s", err, SanitizeBody(marshalled))
 	}
 
 	// Parse the response
 	response := CreatePersonalAccessTokenV1Response{}
 	err = json.Unmarshal(body, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling response for personal access token: %v, body: %s", err, string(body))
+		return nil, fmt.Errorf("error unmarshalling response for personal access token: %v, body: %s", err, SanitizeBody(body))
 	}
 
 	// Validate that the token UUID is present in the response
@@ -16,9 +16,12 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand/v2"
 	"net/http"
+	"time"
 
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
@@ -29,29 +32,80 @@ type CreateProjectV1Results struct {
 	Project          models.Project `json:"project"`
 }
 
+// UnmarshalJSON accepts the project either nested in `results.project` or directly as `results`,
+// so that a normalization of the response envelope by Lightdash doesn't break the creation.
+func (r *CreateProjectV1Results) UnmarshalJSON(data []byte) error {
+	results := struct {
+		HasContentCopy   bool    `json:"hasContentCopy"`
+		ContentCopyError *string `json:"contentCopyError,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return err
+	}
+	project, err := UnmarshalProjectResults(data)
+	if err != nil {
+		return err
+	}
+	r.HasContentCopy = results.HasContentCopy
+	r.ContentCopyError = results.ContentCopyError
+	r.Project = *project
+	return nil
+}
+
+type CreateProjectV1Error struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
 type CreateProjectV1Response struct {
 	Results CreateProjectV1Results `json:"results,omitempty"`
 	Status  string                 `json:"status"`
+	Error   *CreateProjectV1Error  `json:"error,omitempty"`
 }
 
-func (c *Client) CreateProjectV1(project *models.CreateProject) (*models.Project, error) {
+// CreateProjectV1 creates a project. The creation is bounded by the deadline of the context
+// rather than the client timeout, since copying content into a preview project can take minutes.
+func (c *Client) CreateProjectV1(ctx context.Context, project *models.CreateProject) (*CreateProjectV1Results, error) {
+	// Spread out concurrent project creations to avoid bursts against the API
+	if c.CreateProjectMaxJitter > 0 {
+		timer := time.NewTimer(rand.N(c.CreateProjectMaxJitter)) // #nosec G404 -- jitter does not need a secure random source.
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("project creation cancelled: %w", ctx.Err())
+		}
+	}
+
 	// Marshal the request body
 	marshalled, err := json.Marshal(project)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	// The environment variables of the dbt project may hold secrets, so they are masked in the logs and the errors
+	secrets := project.DbtConnection.EnvironmentValues()
+	if project.DbtConnection != nil && project.DbtConnection.APIKey != nil {
+		secrets = append(secrets, *project.DbtConnection.APIKey)
+	}
+	ctx = WithSecrets(ctx, secrets...)
+
+	// The request is bounded by the deadline of the context instead of the client timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		ctx = WithRequestTimeout(ctx, time.Until(deadline))
+	}
+
 	// Create the request
 	path := fmt.Sprintf("%s/api/v1/org/projects", c.HostUrl)
-	req, err := http.NewRequest("POST", path, bytes.NewReader(marshalled))
+	req, err := http.NewRequestWithContext(ctx, "POST", path, bytes.NewReader(marshalled))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new request: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("failed to create new request: %v, body: %s", err, maskSecrets(SanitizeBody(marshalled), secrets))
 	}
 
 	// Do request
 	body, err := c.DoRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("request failed: %w, body: %s", err, maskSecrets(SanitizeBody(marshalled), secrets))
 	}
 
 	// Unmarshal the response
@@ -61,10 +115,18 @@ func (c *Client) CreateProjectV1(project *models.CreateProject) (*models.Project
 		return nil, fmt.Errorf("failed to unmarshal response: %v, body: %s", err, string(body))
 	}
 
+	// Report the error of the server rather than the missing project of a partial response
+	if response.Status != "ok" {
+		if response.Error != nil && response.Error.Message != "" {
+			return nil, fmt.Errorf("project creation failed with status %q: %s: %s", response.Status, response.Error.Name, response.Error.Message)
+		}
+		return nil, fmt.Errorf("project creation failed with status %q, body: %s", response.Status, string(body))
+	}
+
 	// Validate that the project UUID is present in the response
 	if response.Results.Project.ProjectUUID == "" {
 		return nil, fmt.Errorf("project UUID is missing in the response")
 	}
 
-	return &response.Results.Project, nil
+	return &response.Results, nil
 }
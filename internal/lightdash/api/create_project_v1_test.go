@@ -0,0 +1,136 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestCreateProjectV1(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name: "Test with created project",
+			body: `{"status": "ok", "results": {"hasContentCopy": false, "project": {"projectUuid": "project-uuid"}}}`,
+		},
+		{
+			name: "Test with project as results",
+			body: `{"status": "ok", "results": {"projectUuid": "project-uuid", "name": "test"}}`,
+		},
+		{
+			name:          "Test with error status",
+			body:          `{"status": "error", "error": {"name": "ParameterError", "message": "Invalid dbt version"}}`,
+			expectedError: "ParameterError: Invalid dbt version",
+		},
+		{
+			name:          "Test with error status without message",
+			body:          `{"status": "error", "results": {"project": {}}}`,
+			expectedError: `failed with status "error"`,
+		},
+		{
+			name:          "Test with missing project UUID",
+			body:          `{"status": "ok", "results": {"project": {}}}`,
+			expectedError: "project UUID is missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			results, err := client.CreateProjectV1(context.Background(), &models.CreateProject{Name: "test"})
+			if test.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+					t.Errorf("Expected an error containing %q for %s, got: %v", test.expectedError, test.name, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", test.name, err)
+			}
+			if results.Project.ProjectUUID != "project-uuid" {
+				t.Errorf("Expected project UUID project-uuid, got: %s", results.Project.ProjectUUID)
+			}
+		})
+	}
+}
+
+func TestCreateProjectV1MasksEnvironment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status": "error"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	_, err = client.CreateProjectV1(context.Background(), &models.CreateProject{
+		Name: "test",
+		DbtConnection: &models.DbtGithubProjectConfig{
+			Type:        models.DbtProjectTypeNone,
+			Environment: []models.DbtProjectEnvironmentVariable{{Key: "DBT_PASSWORD", Value: "s3cr3t-value"}},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+	if strings.Contains(err.Error(), "s3cr3t-value") {
+		t.Errorf("Expected the environment values to be masked, got: %s", err.Error())
+	}
+	if !strings.Contains(err.Error(), "DBT_PASSWORD") {
+		t.Errorf("Expected the environment keys to be kept, got: %s", err.Error())
+	}
+}
+
+func TestCreateProjectV1JitterCancelled(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Unexpected error creating client: %v", err)
+	}
+	client.CreateProjectMaxJitter = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.CreateProjectV1(ctx, &models.CreateProject{Name: "test"})
+	if err == nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a cancellation error, got: %v", err)
+	}
+	if called {
+		t.Errorf("Expected no request to be sent after cancellation")
+	}
+}
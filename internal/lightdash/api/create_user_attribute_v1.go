@@ -45,7 +45,7 @@ func (c *Client) CreateUserAttributeV1(request *models.CreateUserAttribute) (*mo
 	// Do the request
 	body, err := c.DoRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("error performing POST request for user attribute: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("error performing POST request for user attribute: %v, body: %s", err, SanitizeBody(marshalled))
 	}
 
 	// Parse the response
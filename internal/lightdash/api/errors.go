@@ -0,0 +1,95 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// StatusError is returned when the Lightdash API responds with an unsuccessful status code
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// StatusCodeOf returns the status code of the unsuccessful response that caused the error, if any
+func StatusCodeOf(err error) (int, bool) {
+	var statusError *StatusError
+	if errors.As(err, &statusError) {
+		return statusError.StatusCode, true
+	}
+	return 0, false
+}
+
+// IsNetworkError reports whether the error was caused by a failure to reach the Lightdash host
+func IsNetworkError(err error) bool {
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// ValidationError is a field of a request body rejected by the validation of the Lightdash API
+type ValidationError struct {
+	// Field is the path of the field in the request body, e.g. "warehouseConnection.dataset"
+	Field   string
+	Message string
+}
+
+// validationErrorResponse is the body of a response rejecting a request, whose data names the rejected fields
+type validationErrorResponse struct {
+	Error struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+		Data    map[string]struct {
+			Message string `json:"message"`
+		} `json:"data"`
+	} `json:"error"`
+}
+
+// ValidationErrorsOf returns the fields rejected in the unsuccessful response that caused the error, sorted by field.
+// It returns nil when the response doesn't name any field.
+func ValidationErrorsOf(err error) []ValidationError {
+	var statusError *StatusError
+	if !errors.As(err, &statusError) {
+		return nil
+	}
+	response := validationErrorResponse{}
+	if json.Unmarshal(statusError.Body, &response) != nil {
+		return nil
+	}
+
+	var validationErrors []ValidationError
+	for field, data := range response.Error.Data {
+		// The fields are prefixed by the name of the request body parameter
+		field = strings.TrimPrefix(strings.TrimPrefix(field, "requestBody."), "body.")
+		message := data.Message
+		if message == "" {
+			message = response.Error.Message
+		}
+		validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+	}
+	sort.Slice(validationErrors, func(i, j int) bool {
+		return validationErrors[i].Field < validationErrors[j].Field
+	})
+	return validationErrors
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatusCodeOf(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status": "error"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HTTPClient: server.Client(), HostUrl: server.URL}
+	req, err := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err.Error())
+	}
+	_, err = client.DoRequest(req)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+
+	// The status code is kept through wrapped errors
+	wrapped := fmt.Errorf("request to get health failed: %w", err)
+	statusCode, ok := StatusCodeOf(wrapped)
+	if !ok || statusCode != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got: %d (found: %v)", statusCode, ok)
+	}
+	if IsNetworkError(wrapped) {
+		t.Error("Expected a status error not to be a network error")
+	}
+
+	// Errors without a response have no status code
+	if _, ok := StatusCodeOf(fmt.Errorf("failed to unmarshal response")); ok {
+		t.Error("Expected no status code for an error without a response")
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	// Nothing listens on the closed server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	client := &Client{HTTPClient: &http.Client{}, HostUrl: server.URL}
+	req, err := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err.Error())
+	}
+	_, err = client.DoRequest(req)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+	if !IsNetworkError(fmt.Errorf("request to get health failed: %w", err)) {
+		t.Errorf("Expected a network error, got: %v", err)
+	}
+	if _, ok := StatusCodeOf(err); ok {
+		t.Error("Expected no status code for a network error")
+	}
+}
+
+func TestValidationErrorsOf(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{
+			"status": "error",
+			"error": {
+				"statusCode": 422,
+				"name": "ValidateError",
+				"message": "Validation Failed",
+				"data": {
+					"body.warehouseConnection.dataset": {"message": "invalid string value", "value": 1},
+					"body.name": {}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	req, err := http.NewRequest("POST", server.URL+"/api/v1/org/projects", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err.Error())
+	}
+	_, err = client.DoRequest(req)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+
+	// The fields are kept through wrapped errors, without the prefix of the body parameter
+	validationErrors := ValidationErrorsOf(fmt.Errorf("request failed: %w", err))
+	expected := []ValidationError{
+		{Field: "name", Message: "Validation Failed"},
+		{Field: "warehouseConnection.dataset", Message: "invalid string value"},
+	}
+	if len(validationErrors) != len(expected) {
+		t.Fatalf("Expected %d validation errors, got: %v", len(expected), validationErrors)
+	}
+	for i := range expected {
+		if validationErrors[i] != expected[i] {
+			t.Errorf("Expected validation error %v, got: %v", expected[i], validationErrors[i])
+		}
+	}
+
+	// Errors without a response have no validation errors
+	if validationErrors := ValidationErrorsOf(fmt.Errorf("failed to unmarshal response")); validationErrors != nil {
+		t.Errorf("Expected no validation errors, got: %v", validationErrors)
+	}
+}
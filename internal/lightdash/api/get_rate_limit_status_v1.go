@@ -0,0 +1,36 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetRateLimitStatusV1 sends a lightweight authenticated request and returns the rate limit reported in the headers of its response.
+// Unlike LastRateLimitStatus, the result can't come from a concurrent request of the client.
+func (c *Client) GetRateLimitStatusV1() (RateLimitStatus, error) {
+	path := fmt.Sprintf("%s/api/v1/org", c.HostUrl)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return RateLimitStatus{}, fmt.Errorf("error creating GET request for rate limit status: %v", err)
+	}
+
+	_, header, err := c.doRequest(req)
+	if err != nil {
+		return RateLimitStatus{}, fmt.Errorf("error performing GET request for rate limit status: %w", err)
+	}
+	return parseRateLimitHeaders(header), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type ListExploresV1Response struct {
+	Results []models.Explore `json:"results"`
+	Status  string           `json:"status"`
+}
+
+func (c *Client) ListExploresV1(ctx context.Context, projectUuid string) ([]models.Explore, error) {
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/projects/%s/explores", c.HostUrl, projectUuid)
+	req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GET request for explores: %v", err)
+	}
+
+	// Do the request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing GET request for explores in project '%s': %w", projectUuid, err)
+	}
+
+	// Parse the response
+	response := ListExploresV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response for explores: %v", err)
+	}
+
+	return response.Results, nil
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,14 +24,19 @@ import (
 )
 
 type ListPersonalAccessTokensV1Response struct {
-	Results []models.PersonalAccessToken `json:"results"`
-	Status  string                       `json:"status"`
+	Results *[]models.PersonalAccessToken `json:"results"`
+	Status  string                        `json:"status"`
 }
 
-func (c *Client) ListPersonalAccessTokensV1() ([]models.PersonalAccessToken, error) {
+// ListPersonalAccessTokensV1 lists the personal access tokens of the authenticated user.
+// The request is bounded by the deadline of the context, or DefaultListTimeout when it has none.
+func (c *Client) ListPersonalAccessTokensV1(ctx context.Context) ([]models.PersonalAccessToken, error) {
+	ctx, cancel := WithListTimeout(ctx)
+	defer cancel()
+
 	// Create the request
 	path := fmt.Sprintf("%s/api/v1/user/me/personal-access-tokens", c.HostUrl)
-	req, err := http.NewRequest("GET", path, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GET request for personal access tokens: %v", err)
 	}
@@ -48,5 +54,13 @@ func (c *Client) ListPersonalAccessTokensV1() ([]models.PersonalAccessToken, err
 		return nil, fmt.Errorf("error unmarshalling response for personal access tokens: %v", err)
 	}
 
-	return response.Results, nil
+	// Distinguish an empty list of tokens from an unexpected response shape
+	if response.Status != "ok" {
+		return nil, fmt.Errorf("unexpected status for personal access tokens: %q, body: %s", response.Status, string(body))
+	}
+	if response.Results == nil {
+		return nil, fmt.Errorf("results are missing in the response for personal access tokens, body: %s", string(body))
+	}
+
+	return *response.Results, nil
 }
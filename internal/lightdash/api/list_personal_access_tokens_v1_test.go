@@ -0,0 +1,89 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPersonalAccessTokensV1(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:          "Test with tokens",
+			body:          `{"status": "ok", "results": [{"uuid": "token-uuid", "description": "ci"}]}`,
+			expectedCount: 1,
+		},
+		{
+			name:          "Test with no tokens",
+			body:          `{"status": "ok", "results": []}`,
+			expectedCount: 0,
+		},
+		{
+			name:        "Test with missing results",
+			body:        `{"status": "ok"}`,
+			expectError: true,
+		},
+		{
+			name:        "Test with null results",
+			body:        `{"status": "ok", "results": null}`,
+			expectError: true,
+		},
+		{
+			name:        "Test with unexpected status",
+			body:        `{"status": "error", "results": []}`,
+			expectError: true,
+		},
+		{
+			name:        "Test with malformed body",
+			body:        `<html>Bad Gateway</html>`,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			tokens, err := client.ListPersonalAccessTokensV1(context.Background())
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected an error for %s, got none", test.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", test.name, err)
+			}
+			if tokens == nil {
+				t.Errorf("Expected a non-nil list of tokens for %s", test.name)
+			}
+			if len(tokens) != test.expectedCount {
+				t.Errorf("Expected %d tokens for %s, got %d", test.expectedCount, test.name, len(tokens))
+			}
+		})
+	}
+}
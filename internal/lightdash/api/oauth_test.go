@@ -0,0 +1,116 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOAuthClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/oauth/token":
+			tokenRequests++
+			if err := r.ParseForm(); err != nil {
+				t.Fatalf("Failed to parse token request: %s", err.Error())
+			}
+			if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_id") != "my-client" || r.PostForm.Get("client_secret") != "my-secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+		default:
+			if r.Header.Get("Authorization") != "Bearer access-token" {
+				t.Errorf("Expected Authorization header 'Bearer access-token', got: %s", r.Header.Get("Authorization"))
+			}
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.SetOAuthClientCredentials("my-client", "my-secret")
+	now := time.Now()
+	client.oauth.now = func() time.Time { return now }
+
+	// The access token is cached until it is about to expire
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", server.URL+"/api/v1/org", nil)
+		if _, err := client.DoRequest(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+	}
+	if tokenRequests != 1 {
+		t.Errorf("Expected 1 token request, got: %d", tokenRequests)
+	}
+
+	// The access token is refreshed before it expires
+	now = now.Add(3600*time.Second - oauthTokenRefreshMargin)
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/org", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if tokenRequests != 2 {
+		t.Errorf("Expected 2 token requests, got: %d", tokenRequests)
+	}
+
+	// Invalid client credentials fail the requests
+	client.SetOAuthClientCredentials("my-client", "wrong-secret")
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/org", nil)
+	_, err = client.DoRequest(req)
+	if statusCode, ok := StatusCodeOf(err); !ok || statusCode != http.StatusUnauthorized {
+		t.Errorf("Expected a 401 error, got: %v", err)
+	}
+}
+
+func TestOAuthTokenRequestExtraHeaders(t *testing.T) {
+	// The authentication proxy rejects every request without its header, including the token requests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Proxy-Auth") != "proxy-secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/oauth/token":
+			if r.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+				t.Errorf("Expected a form token request, got Content-Type: %s", r.Header.Get("Content-Type"))
+			}
+			_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.ExtraHeaders = map[string]string{"X-Proxy-Auth": "proxy-secret"}
+	client.SetOAuthClientCredentials("my-client", "my-secret")
+
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/org", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+}
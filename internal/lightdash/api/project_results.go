@@ -0,0 +1,46 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// UnmarshalProjectResults decodes the project of the results of a response.
+// Some endpoints nest the project in `results.project` while others return it as `results`,
+// so the nested project is tried first and the results themselves are the fallback.
+func UnmarshalProjectResults(results json.RawMessage) (*models.Project, error) {
+	nested := struct {
+		Project *models.Project `json:"project"`
+	}{}
+	if err := json.Unmarshal(results, &nested); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal project results: %w", err)
+	}
+	if nested.Project != nil && nested.Project.ProjectUUID != "" {
+		return nested.Project, nil
+	}
+
+	project := models.Project{}
+	if err := json.Unmarshal(results, &project); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal project results: %w", err)
+	}
+	if project.ProjectUUID == "" && nested.Project != nil {
+		return nested.Project, nil
+	}
+	return &project, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalProjectResults(t *testing.T) {
+	tests := []struct {
+		name         string
+		results      string
+		expectedUuid string
+		expectError  bool
+	}{
+		{
+			name:         "nested project",
+			results:      `{"hasContentCopy": false, "project": {"projectUuid": "project-uuid", "name": "analytics"}}`,
+			expectedUuid: "project-uuid",
+		},
+		{
+			name:         "project as results",
+			results:      `{"projectUuid": "project-uuid", "name": "analytics"}`,
+			expectedUuid: "project-uuid",
+		},
+		{
+			name:         "nested project without UUID",
+			results:      `{"project": {"name": "analytics"}}`,
+			expectedUuid: "",
+		},
+		{
+			name:        "invalid results",
+			results:     `["project-uuid"]`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, err := UnmarshalProjectResults(json.RawMessage(tt.results))
+			if (err != nil) != tt.expectError {
+				t.Fatalf("UnmarshalProjectResults() error = %v, expectError %v", err, tt.expectError)
+			}
+			if err == nil && project.ProjectUUID != tt.expectedUuid {
+				t.Errorf("UnmarshalProjectResults() project UUID = %q, want %q", project.ProjectUUID, tt.expectedUuid)
+			}
+		})
+	}
+}
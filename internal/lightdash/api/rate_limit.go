@@ -0,0 +1,87 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// RateLimitStatus describes the rate limit reported by the Lightdash API in the response headers
+type RateLimitStatus struct {
+	Limit     *int64
+	Remaining *int64
+	Reset     *int64
+}
+
+// IsEmpty reports whether the response didn't carry any rate limit header
+func (s RateLimitStatus) IsEmpty() bool {
+	return s.Limit == nil && s.Remaining == nil && s.Reset == nil
+}
+
+func (s RateLimitStatus) logFields(req *http.Request, statusCode int) map[string]interface{} {
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"status_code": statusCode,
+	}
+	if s.Limit != nil {
+		fields["rate_limit_limit"] = *s.Limit
+	}
+	if s.Remaining != nil {
+		fields["rate_limit_remaining"] = *s.Remaining
+	}
+	if s.Reset != nil {
+		fields["rate_limit_reset"] = *s.Reset
+	}
+	return fields
+}
+
+// parseRateLimitHeaders reads both the standard `RateLimit-*` and the legacy `X-RateLimit-*` headers
+func parseRateLimitHeaders(header http.Header) RateLimitStatus {
+	return RateLimitStatus{
+		Limit:     parseRateLimitHeader(header, "RateLimit-Limit"),
+		Remaining: parseRateLimitHeader(header, "RateLimit-Remaining"),
+		Reset:     parseRateLimitHeader(header, "RateLimit-Reset"),
+	}
+}
+
+func parseRateLimitHeader(header http.Header, name string) *int64 {
+	value := header.Get(name)
+	if value == "" {
+		value = header.Get("X-" + name)
+	}
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+// LastRateLimitStatus returns the rate limit reported by the most recent response that carried rate limit headers
+func (c *Client) LastRateLimitStatus() RateLimitStatus {
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+	return c.lastRateLimitStatus
+}
+
+func (c *Client) recordRateLimitStatus(status RateLimitStatus) {
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+	c.lastRateLimitStatus = status
+}
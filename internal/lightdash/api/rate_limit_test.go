@@ -0,0 +1,88 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	// Standard headers
+	header := http.Header{}
+	header.Set("RateLimit-Limit", "100")
+	header.Set("RateLimit-Remaining", "42")
+	header.Set("RateLimit-Reset", "30")
+	status := parseRateLimitHeaders(header)
+	if status.Limit == nil || *status.Limit != 100 {
+		t.Errorf("Expected Limit: 100, got: %v", status.Limit)
+	}
+	if status.Remaining == nil || *status.Remaining != 42 {
+		t.Errorf("Expected Remaining: 42, got: %v", status.Remaining)
+	}
+	if status.Reset == nil || *status.Reset != 30 {
+		t.Errorf("Expected Reset: 30, got: %v", status.Reset)
+	}
+
+	// Legacy headers
+	header = http.Header{}
+	header.Set("X-RateLimit-Remaining", "7")
+	status = parseRateLimitHeaders(header)
+	if status.Remaining == nil || *status.Remaining != 7 {
+		t.Errorf("Expected Remaining: 7, got: %v", status.Remaining)
+	}
+	if status.Limit != nil {
+		t.Errorf("Expected nil Limit, got: %v", *status.Limit)
+	}
+
+	// No headers or invalid values
+	header = http.Header{}
+	header.Set("RateLimit-Limit", "invalid")
+	if status := parseRateLimitHeaders(header); !status.IsEmpty() {
+		t.Errorf("Expected empty rate limit status, got: %+v", status)
+	}
+}
+
+func TestGetRateLimitStatusV1(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/org" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("RateLimit-Limit", "100")
+		w.Header().Set("RateLimit-Remaining", "99")
+		_, _ = w.Write([]byte(`{"status": "ok", "results": {}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	// A status recorded from another request must not leak into the probe
+	reset := int64(60)
+	client.recordRateLimitStatus(RateLimitStatus{Reset: &reset})
+
+	status, err := client.GetRateLimitStatusV1()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if status.Limit == nil || *status.Limit != 100 || status.Remaining == nil || *status.Remaining != 99 {
+		t.Errorf("Expected the headers of the probe response, got: %+v", status)
+	}
+	if status.Reset != nil {
+		t.Errorf("Expected nil Reset from the probe response, got: %v", *status.Reset)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries of a request after a transient error.
+	DefaultMaxRetries = 3
+	// DefaultMaxNetworkRetries is the default number of retries of a request after a network error.
+	DefaultMaxNetworkRetries = 2
+	// DefaultRetryWaitMin is the default delay before the first retry, which doubles at each retry.
+	DefaultRetryWaitMin = 1 * time.Second
+	// DefaultRetryWaitMax is the default upper bound of the delay between retries.
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// isRetriable reports whether a request may succeed when it is sent again after a response with the status code.
+// Only rate limiting and server errors are transient. Other client errors, e.g. validation errors, are permanent.
+func isRetriable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || (statusCode >= 500 && statusCode <= 599)
+}
+
+// canRetry reports whether the request can be sent again after a response with the status code.
+// Server errors are only retried for idempotent methods, since the server may have applied a creation before failing.
+// Rate limited requests were not processed, so they are retried whatever their method.
+func (c *Client) canRetry(req *http.Request, statusCode int) bool {
+	if !isRetriable(statusCode) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	return statusCode == http.StatusTooManyRequests || isIdempotent(req.Method)
+}
+
+// isTransientNetworkError reports whether a request failed before any response because the Lightdash host
+// was briefly unreachable, e.g. a refused or reset connection, a DNS failure or a timeout.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var dnsError *net.DNSError
+	var opError *net.OpError
+	if errors.As(err, &dnsError) || errors.As(err, &opError) {
+		return true
+	}
+	var netError net.Error
+	return errors.As(err, &netError) && netError.Timeout()
+}
+
+// isNotSent reports whether the network error occurred before the request reached the Lightdash host
+func isNotSent(err error) bool {
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) {
+		return true
+	}
+	var opError *net.OpError
+	return errors.As(err, &opError) && opError.Op == "dial"
+}
+
+// canRetryNetworkError reports whether the request can be sent again after it failed with the error.
+// A request that may have reached the server is only retried for idempotent methods, like after a server error.
+func (c *Client) canRetryNetworkError(req *http.Request, err error) bool {
+	// A cancelled or expired request fails with a network error, but it must not be retried
+	if req.Context().Err() != nil || !isTransientNetworkError(err) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	return isIdempotent(req.Method) || isNotSent(err)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns the delay before the retry following the attempt, which starts from 0.
+// The Retry-After header of the response takes precedence over the exponential backoff.
+func (c *Client) retryDelay(attempt int, header http.Header) time.Duration {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		delay := time.Duration(seconds) * time.Second
+		if c.RetryWaitMax > 0 && delay > c.RetryWaitMax {
+			return c.RetryWaitMax
+		}
+		return delay
+	}
+
+	delay := c.RetryWaitMin
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if c.RetryWaitMax > 0 && delay >= c.RetryWaitMax {
+			return c.RetryWaitMax
+		}
+	}
+	return delay
+}
+
+// retryBudget bounds the total time spent waiting for retries by all the requests of a client.
+// A widespread outage then fails the apply promptly instead of every resource retrying on its own.
+type retryBudget struct {
+	mutex     sync.Mutex
+	remaining time.Duration
+}
+
+// reserve takes the delay from the budget, unless the budget is too low for it
+func (b *retryBudget) reserve(delay time.Duration) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if delay > b.remaining {
+		return false
+	}
+	b.remaining -= delay
+	return true
+}
+
+// SetRetryBudget bounds the total time spent waiting for retries across all the requests of the client,
+// including the clients derived from it with WithHost.
+func (c *Client) SetRetryBudget(budget time.Duration) {
+	c.retryBudget = &retryBudget{remaining: budget}
+}
+
+// reserveRetry reports whether a retry after the delay fits in the retry budget, which is unlimited by default
+func (c *Client) reserveRetry(delay time.Duration) bool {
+	if c.retryBudget == nil {
+		return true
+	}
+	return c.retryBudget.reserve(delay)
+}
+
+// logRetry logs the retry following the failed attempt, which starts from 1, with the reason of the failure
+func (c *Client) logRetry(req *http.Request, attempt int, delay time.Duration, reason string) {
+	if !c.LogRetries {
+		return
+	}
+	tflog.Warn(c.logContext(req), "Retrying "+requestLogMessage(req), map[string]interface{}{
+		"attempt": attempt,
+		"delay":   delay.String(),
+		"reason":  reason,
+	})
+}
+
+// WaitForRetry waits for the delay, unless the context is done first
+func WaitForRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rewindBody resets the body of the request before it is sent again
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("error rewinding the request body for a retry: %w", err)
+	}
+	req.Body = body
+	return nil
+}
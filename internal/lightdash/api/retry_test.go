@@ -0,0 +1,309 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestIsRetriable(t *testing.T) {
+	testCases := map[int]bool{
+		http.StatusBadRequest:          false,
+		http.StatusUnauthorized:        false,
+		http.StatusForbidden:           false,
+		http.StatusNotFound:            false,
+		http.StatusConflict:            false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusServiceUnavailable:  true,
+	}
+	for statusCode, expected := range testCases {
+		if got := isRetriable(statusCode); got != expected {
+			t.Errorf("isRetriable(%d): expected %v, got %v", statusCode, expected, got)
+		}
+	}
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	var attempts atomic.Int32
+	var statusCodes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(attempts.Add(1)) - 1
+		if attempt < len(statusCodes) {
+			w.WriteHeader(statusCodes[attempt])
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+
+	// A transient server error is retried until it succeeds
+	attempts.Store(0)
+	statusCodes = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got: %d", attempts.Load())
+	}
+
+	// A client error fails right away
+	attempts.Store(0)
+	statusCodes = []int{http.StatusBadRequest}
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	_, err = client.DoRequest(req)
+	if statusCode, _ := StatusCodeOf(err); statusCode != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got: %v", err)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got: %d", attempts.Load())
+	}
+
+	// A server error of a creation is not retried, but a rate limited creation is, with its body
+	attempts.Store(0)
+	statusCodes = []int{http.StatusInternalServerError}
+	req, _ = http.NewRequest("POST", server.URL+"/api/v1/projects", strings.NewReader(`{}`))
+	_, err = client.DoRequest(req)
+	if statusCode, _ := StatusCodeOf(err); statusCode != http.StatusInternalServerError {
+		t.Errorf("Expected status code 500, got: %v", err)
+	}
+	attempts.Store(0)
+	statusCodes = []int{http.StatusTooManyRequests}
+	req, _ = http.NewRequest("POST", server.URL+"/api/v1/projects", strings.NewReader(`{}`))
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	if attempts.Load() != 2 {
+		t.Errorf("Expected 2 attempts, got: %d", attempts.Load())
+	}
+
+	// The retries are bounded
+	attempts.Store(0)
+	statusCodes = []int{500, 500, 500, 500, 500}
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	var statusError *StatusError
+	if _, err := client.DoRequest(req); !errors.As(err, &statusError) {
+		t.Errorf("Expected a status error, got: %v", err)
+	}
+	if int(attempts.Load()) != DefaultMaxRetries+1 {
+		t.Errorf("Expected %d attempts, got: %d", DefaultMaxRetries+1, attempts.Load())
+	}
+}
+
+func TestDoRequestRetryBudget(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.RetryWaitMin = 10 * time.Millisecond
+	client.RetryWaitMax = 10 * time.Millisecond
+	client.SetRetryBudget(25 * time.Millisecond)
+
+	// The budget covers two retries of the first request
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	_, err = client.DoRequest(req)
+	if statusCode, _ := StatusCodeOf(err); statusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code 503, got: %v", err)
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got: %d", attempts.Load())
+	}
+
+	// The budget is shared, so the next requests fail without retries, including those to another host
+	attempts.Store(0)
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.WithHost(server.URL, "token").DoRequest(req); err == nil || !strings.Contains(err.Error(), "retry budget") {
+		t.Errorf("Expected an exhausted retry budget error, got: %v", err)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got: %d", attempts.Load())
+	}
+}
+
+func TestDoRequestNetworkRetries(t *testing.T) {
+	var attempts atomic.Int32
+	var resets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The connection is closed without a response, like a Lightdash pod being restarted
+		if attempts.Add(1) <= resets.Load() {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		// The connections are not reused, since the transport itself retries a request on a reused connection
+		w.Header().Set("Connection", "close")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+
+	// A closed connection is retried apart from the status retries
+	resets.Store(DefaultMaxNetworkRetries)
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	if int(attempts.Load()) != DefaultMaxNetworkRetries+1 {
+		t.Errorf("Expected %d attempts, got: %d", DefaultMaxNetworkRetries+1, attempts.Load())
+	}
+
+	// The network retries are bounded
+	attempts.Store(0)
+	resets.Store(DefaultMaxNetworkRetries + 1)
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err == nil {
+		t.Error("Expected an error, got none")
+	}
+	if int(attempts.Load()) != DefaultMaxNetworkRetries+1 {
+		t.Errorf("Expected %d attempts, got: %d", DefaultMaxNetworkRetries+1, attempts.Load())
+	}
+
+	// A creation may have been applied before the connection was closed, so it is not retried
+	attempts.Store(0)
+	resets.Store(1)
+	req, _ = http.NewRequest("POST", server.URL+"/api/v1/projects", strings.NewReader(`{}`))
+	if _, err := client.DoRequest(req); err == nil {
+		t.Error("Expected an error, got none")
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got: %d", attempts.Load())
+	}
+}
+
+func TestDoRequestLogsRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+
+	// Each retry is logged as a warning with its attempt, delay and reason
+	var output bytes.Buffer
+	req, _ := http.NewRequestWithContext(tflogtest.RootLogger(context.Background(), &output), "GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Error decoding the logs: %s", err.Error())
+	}
+	warnings := []map[string]interface{}{}
+	for _, entry := range entries {
+		if entry["@level"] == "warn" {
+			warnings = append(warnings, entry)
+		}
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got: %v", warnings)
+	}
+	if warnings[0]["@message"] != "Retrying GET /api/v1/health" || warnings[0]["attempt"] != float64(1) || warnings[0]["delay"] != "1ms" || warnings[0]["reason"] != "status code 503" {
+		t.Errorf("Unexpected retry warning: %v", warnings[0])
+	}
+
+	// The warnings can be turned off
+	attempts.Store(0)
+	output.Reset()
+	client.LogRetries = false
+	req, _ = http.NewRequestWithContext(tflogtest.RootLogger(context.Background(), &output), "GET", server.URL+"/api/v1/health", nil)
+	if _, err := client.DoRequest(req); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if strings.Contains(output.String(), `"@level":"warn"`) {
+		t.Errorf("Expected no retry warning, got: %s", output.String())
+	}
+}
+
+func TestCanRetryNetworkError(t *testing.T) {
+	// Nothing listens on the closed server, so the connection is refused before the request is sent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+	_, refused := (&http.Client{}).Get(server.URL)
+
+	client := &Client{}
+	post, _ := http.NewRequest("POST", server.URL+"/api/v1/projects", strings.NewReader(`{}`))
+	if !client.canRetryNetworkError(post, refused) {
+		t.Errorf("Expected a refused creation to be retried, got: %v", refused)
+	}
+	if client.canRetryNetworkError(post, errors.New("unsupported protocol scheme")) {
+		t.Error("Expected a permanent error not to be retried")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	get, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/v1/health", nil)
+	if client.canRetryNetworkError(get, refused) {
+		t.Error("Expected a cancelled request not to be retried")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := &Client{RetryWaitMin: time.Second, RetryWaitMax: 5 * time.Second}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if got := client.retryDelay(attempt, http.Header{}); got != expected {
+			t.Errorf("Attempt %d: expected %s, got %s", attempt, expected, got)
+		}
+	}
+
+	header := http.Header{}
+	header.Set("Retry-After", "3")
+	if got := client.retryDelay(0, header); got != 3*time.Second {
+		t.Errorf("Expected the Retry-After delay, got %s", got)
+	}
+	header.Set("Retry-After", "60")
+	if got := client.retryDelay(0, header); got != 5*time.Second {
+		t.Errorf("Expected the maximum delay, got %s", got)
+	}
+}
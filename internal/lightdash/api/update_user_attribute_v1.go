@@ -51,7 +51,7 @@ func (c *Client) UpdateUserAttributeV1(userAttributeUuid string, request *models
 	// Do the request
 	body, err := c.DoRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("error performing PUT request for user attribute UUID '%s': %v, body: %s", userAttributeUuid, err, string(marshalled))
+		return nil, fmt.Errorf("error performing PUT request for user attribute UUID '%s': %v, body: %s", userAttributeUuid, err, SanitizeBody(marshalled))
 	}
 
 	// Parse the response
@@ -59,12 +59,12 @@ func CreateGroupInOrganizationV1(c *api.Client, organizationUuid string, groupNa
 	path := fmt.Sprintf("%s/api/v1/org/groups", c.HostUrl)
 	req, err := http.NewRequest("POST", path, bytes.NewReader(marshalled))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new request: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("failed to create new request: %v, body: %s", err, api.SanitizeBody(marshalled))
 	}
 	// Do request
 	body, err := c.DoRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %v, body: %s", err, string(marshalled))
+		return nil, fmt.Errorf("request failed: %v, body: %s", err, api.SanitizeBody(marshalled))
 	}
 	// Marshal the response
 	response := CreateGroupInOrganizationV1Response{}
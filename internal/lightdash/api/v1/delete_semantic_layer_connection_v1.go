@@ -0,0 +1,40 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// DeleteSemanticLayerConnectionV1 disconnects a project from its semantic layer, which stops syncing its metrics
+func DeleteSemanticLayerConnectionV1(c *api.Client, projectUuid string) error {
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/projects/%s/semantic-layer-connection", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating DELETE request for semantic layer connection: %w", err)
+	}
+
+	// Do request
+	_, err = c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("error performing DELETE request for semantic layer connection of project (%s): %w", projectUuid, err)
+	}
+
+	return nil
+}
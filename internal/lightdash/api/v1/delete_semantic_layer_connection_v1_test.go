@@ -0,0 +1,75 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+func TestDeleteSemanticLayerConnectionV1(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		response    string
+		expectError bool
+	}{
+		{
+			name:       "Test with a removed connection",
+			statusCode: http.StatusOK,
+			response:   `{"status": "ok"}`,
+		},
+		{
+			name:        "Test with a missing project",
+			statusCode:  http.StatusNotFound,
+			response:    `{"status": "error", "error": {"statusCode": 404, "name": "NotFoundError", "message": "Project not found"}}`,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Method != "DELETE" || r.URL.Path != "/api/v1/projects/project-uuid/semantic-layer-connection" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			err := DeleteSemanticLayerConnectionV1(client, "project-uuid")
+			if test.expectError && err == nil {
+				t.Errorf("Expected an error for %s, got none", test.name)
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("Unexpected error for %s: %v", test.name, err)
+			}
+			if requests != 1 {
+				t.Errorf("Expected 1 request for %s, got %d", test.name, requests)
+			}
+			// The status code is kept, so that a missing project can be told apart
+			if statusCode, ok := api.StatusCodeOf(err); test.expectError && (!ok || statusCode != test.statusCode) {
+				t.Errorf("Expected status code %d for %s, got %d", test.statusCode, test.name, statusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type GetHealthV1AuthProvider struct {
+	Enabled bool `json:"enabled"`
+}
+
+type GetHealthV1Auth struct {
+	DisablePasswordAuthentication bool                    `json:"disablePasswordAuthentication"`
+	Google                        GetHealthV1AuthProvider `json:"google"`
+	Okta                          GetHealthV1AuthProvider `json:"okta"`
+	OneLogin                      GetHealthV1AuthProvider `json:"oneLogin"`
+	AzureAD                       GetHealthV1AuthProvider `json:"azuread"`
+	OIDC                          GetHealthV1AuthProvider `json:"oidc"`
+}
+
+type GetHealthV1Results struct {
+	Healthy                 bool            `json:"healthy"`
+	Mode                    string          `json:"mode"`
+	Version                 string          `json:"version"`
+	LocalDbtEnabled         bool            `json:"localDbtEnabled"`
+	IsAuthenticated         bool            `json:"isAuthenticated"`
+	RequiresOrgRegistration bool            `json:"requiresOrgRegistration"`
+	SiteUrl                 string          `json:"siteUrl"`
+	HasGithub               bool            `json:"hasGithub"`
+	HasGitlab               bool            `json:"hasGitlab"`
+	Auth                    GetHealthV1Auth `json:"auth"`
+	// SupportedTimezones restricts the scheduler timezones. It is empty when the instance doesn't restrict them.
+	SupportedTimezones []string `json:"supportedTimezones,omitempty"`
+	// SupportedDbtVersions lists the dbt versions the instance can compile. It is empty when the instance doesn't report them.
+	SupportedDbtVersions []string `json:"supportedDbtVersions,omitempty"`
+}
+
+type GetHealthV1Response struct {
+	Results GetHealthV1Results `json:"results,omitempty"`
+	Status  string             `json:"status"`
+}
+
+// GetHealthV1 gets the health and the capabilities of the Lightdash instance
+func GetHealthV1(c *api.Client) (*GetHealthV1Results, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/health", c.HostUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for health: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to get health failed: %w", err)
+	}
+
+	response := GetHealthV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal health response: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return nil, fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return &response.Results, nil
+}
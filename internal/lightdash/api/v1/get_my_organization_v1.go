@@ -23,8 +23,10 @@ import (
 )
 
 type GetMyOrganizationV1Results struct {
-	OrganizationUUID string `json:"organizationUuid"`
-	Name             string `json:"name"`
+	OrganizationUUID   string   `json:"organizationUuid"`
+	Name               string   `json:"name"`
+	ChartColors        []string `json:"chartColors,omitempty"`
+	DefaultProjectUUID *string  `json:"defaultProjectUuid,omitempty"`
 }
 
 type GetMyOrganizationV1Response struct {
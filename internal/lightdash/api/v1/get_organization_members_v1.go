@@ -15,6 +15,7 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -49,8 +50,10 @@ type GetOrganizationMembersV1Response struct {
 	Status string `json:"status"`
 }
 
-func GetOrganizationMembersV1(c *api.Client, includeGroups, pageSize, page int, searchQuery string) ([]GetOrganizationMembersV1Results, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/org/users", c.HostUrl), nil)
+// GetOrganizationMembersV1 gets a page of the members of the organization.
+// The request is bounded by the deadline of the context.
+func GetOrganizationMembersV1(ctx context.Context, c *api.Client, includeGroups, pageSize, page int, searchQuery string) ([]GetOrganizationMembersV1Results, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/org/users", c.HostUrl), nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new request for organization members: %w", err)
 	}
@@ -15,11 +15,13 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
 type GetProjectV1Results struct {
@@ -28,9 +30,24 @@ type GetProjectV1Results struct {
 	ProjectName                          string  `json:"name"`
 	ProjectType                          string  `json:"type"`
 	SchedulerTimezone                    string  `json:"schedulerTimezone"`
+	SchedulerEnabled                     *bool   `json:"schedulerEnabled,omitempty"`
 	DbtVersion                           string  `json:"dbtVersion,omitempty"`
 	OrganizationWarehouseCredentialsUUID *string `json:"organizationWarehouseCredentialsUuid,omitempty"`
 	UpstreamProjectUUID                  *string `json:"upstreamProjectUuid,omitempty"`
+	PinnedListUUID                       *string `json:"pinnedListUuid,omitempty"`
+	QueryRowLimit                        *int64  `json:"queryRowLimit,omitempty"`
+	// SemanticLayerConnection only contains the non-sensitive fields; the token is stripped by the API
+	SemanticLayerConnection *models.SemanticLayerConnection `json:"semanticLayerConnection,omitempty"`
+	// WarehouseConnection only contains the non-sensitive fields; secrets are stripped by the API
+	WarehouseConnection *models.WarehouseConnection `json:"warehouseConnection,omitempty"`
+	// DbtConnection only contains the type and the non-sensitive fields read back, since the other fields depend on the type and may hold secrets
+	DbtConnection *GetProjectV1DbtConnection `json:"dbtConnection,omitempty"`
+}
+
+type GetProjectV1DbtConnection struct {
+	Type string `json:"type"`
+	// EnvironmentID is only set for "dbt_cloud_ide" connections
+	EnvironmentID *string `json:"environment_id,omitempty"`
 }
 
 type GetProjectV1Response struct {
@@ -38,9 +55,9 @@ type GetProjectV1Response struct {
 	Status  string              `json:"status"`
 }
 
-func GetProjectV1(c *api.Client, projectUuid string) (*GetProjectV1Results, error) {
+func GetProjectV1(ctx context.Context, c *api.Client, projectUuid string) (*GetProjectV1Results, error) {
 	path := fmt.Sprintf("%s/api/v1/projects/%s", c.HostUrl, projectUuid)
-	req, err := http.NewRequest("GET", path, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new request for project: %w", err)
 	}
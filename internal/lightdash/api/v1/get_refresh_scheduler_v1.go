@@ -0,0 +1,51 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type GetRefreshSchedulerV1Response struct {
+	Results *models.ProjectRefreshScheduler `json:"results,omitempty"`
+	Status  string                          `json:"status"`
+}
+
+// GetRefreshSchedulerV1 gets the scheduled refresh of the dbt project of a project.
+// It returns nil when no refresh is scheduled.
+func GetRefreshSchedulerV1(c *api.Client, projectUuid string) (*models.ProjectRefreshScheduler, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/refresh-scheduler", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for refresh scheduler: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for refresh scheduler of project %s: %w", projectUuid, err)
+	}
+
+	response := GetRefreshSchedulerV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling refresh scheduler response: %w", err)
+	}
+	return response.Results, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestGetRefreshSchedulerV1(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected *models.ProjectRefreshScheduler
+	}{
+		{
+			name:     "Test with a scheduled refresh",
+			response: `{"status": "ok", "results": {"cron": "0 6 * * *", "enabled": true}}`,
+			expected: &models.ProjectRefreshScheduler{Cron: "0 6 * * *", Enabled: true},
+		},
+		{
+			name:     "Test without a scheduled refresh",
+			response: `{"status": "ok", "results": null}`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/api/v1/projects/project-uuid/refresh-scheduler" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			scheduler, err := GetRefreshSchedulerV1(client, "project-uuid")
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", test.name, err)
+			}
+			if !reflect.DeepEqual(scheduler, test.expected) {
+				t.Errorf("Expected %+v for %s, got %+v", test.expected, test.name, scheduler)
+			}
+		})
+	}
+}
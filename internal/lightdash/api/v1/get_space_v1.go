@@ -49,6 +49,12 @@ type ChildSpace struct {
 	IsPrivate        bool   `json:"isPrivate"`
 }
 
+// SpaceContentItem is a chart or a dashboard stored in a space
+type SpaceContentItem struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
 type GetSpaceV1Results struct {
 	// The response doesn't contain the OrganizationUUID right now
 	// OrganizationUUID string              `json:"organizationUuid"`
@@ -60,6 +66,8 @@ type GetSpaceV1Results struct {
 	ChildSpaces        []ChildSpace        `json:"childSpaces"`
 	SpaceAccessMembers []SpaceAccessMember `json:"access"`
 	SpaceAccessGroups  []SpaceAccessGroup  `json:"groupsAccess"`
+	Queries            []SpaceContentItem  `json:"queries"`
+	Dashboards         []SpaceContentItem  `json:"dashboards"`
 }
 
 type GetSpaceV1Response struct {
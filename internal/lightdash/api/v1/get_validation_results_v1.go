@@ -0,0 +1,59 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type GetValidationResultsV1Results struct {
+	ValidationID int64  `json:"validationId"`
+	CreatedAt    string `json:"createdAt"`
+	Name         string `json:"name"`
+	Error        string `json:"error"`
+	ErrorType    string `json:"errorType"`
+	// Source is either "chart", "dashboard" or "table"
+	Source string `json:"source"`
+}
+
+type GetValidationResultsV1Response struct {
+	Results []GetValidationResultsV1Results `json:"results,omitempty"`
+	Status  string                          `json:"status"`
+}
+
+// GetValidationResultsV1 gets the errors found by the latest validation of a project.
+func GetValidationResultsV1(c *api.Client, projectUuid string) ([]GetValidationResultsV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/validate", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for validation results: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for validation results of project %s: %w", projectUuid, err)
+	}
+
+	response := GetValidationResultsV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling validation results response: %w", err)
+	}
+	return response.Results, nil
+}
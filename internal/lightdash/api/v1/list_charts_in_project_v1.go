@@ -0,0 +1,59 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ListChartsInProjectV1Results struct {
+	ChartUUID   string  `json:"uuid"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	SpaceUUID   string  `json:"spaceUuid"`
+	ChartType   *string `json:"chartType,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+}
+
+type ListChartsInProjectV1Response struct {
+	Results []ListChartsInProjectV1Results `json:"results,omitempty"`
+	Status  string                         `json:"status"`
+}
+
+// ListChartsInProjectV1 lists the saved charts of a project that the authenticated user can view.
+func ListChartsInProjectV1(c *api.Client, projectUuid string) ([]ListChartsInProjectV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/charts", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for charts: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for charts: %w", err)
+	}
+
+	response := ListChartsInProjectV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling charts response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ListColorPalettesV1Results struct {
+	ColorPaletteUUID string   `json:"colorPaletteUuid"`
+	Name             string   `json:"name"`
+	Colors           []string `json:"colors"`
+	IsActive         bool     `json:"isActive"`
+}
+
+type ListColorPalettesV1Response struct {
+	Results []ListColorPalettesV1Results `json:"results,omitempty"`
+	Status  string                       `json:"status"`
+}
+
+// ListColorPalettesV1 lists the color palettes of the organization of the token
+func ListColorPalettesV1(c *api.Client) ([]ListColorPalettesV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/org/color-palettes", c.HostUrl)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for color palettes: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for color palettes: %w", err)
+	}
+
+	response := ListColorPalettesV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling color palettes response: %w", err)
+	}
+	return response.Results, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ListDataCatalogFieldsV1Results struct {
+	// Type is either "field" or "table"
+	Type        string  `json:"type"`
+	Name        string  `json:"name"`
+	Label       string  `json:"label"`
+	TableName   string  `json:"tableName"`
+	Description *string `json:"description,omitempty"`
+	// FieldType is either "dimension" or "metric"
+	FieldType string `json:"fieldType"`
+	BasicType string `json:"basicType"`
+}
+
+type ListDataCatalogFieldsV1Response struct {
+	Results []ListDataCatalogFieldsV1Results `json:"results,omitempty"`
+	Status  string                           `json:"status"`
+}
+
+// ListDataCatalogFieldsV1 lists the dimensions and metrics of the data catalog of a project.
+// The fields are filtered by the search text when it isn't empty.
+func ListDataCatalogFieldsV1(c *api.Client, projectUuid string, search string) ([]ListDataCatalogFieldsV1Results, error) {
+	query := url.Values{}
+	query.Set("type", "field")
+	if search != "" {
+		query.Set("search", search)
+	}
+	path := fmt.Sprintf("%s/api/v1/projects/%s/dataCatalog?%s", c.HostUrl, projectUuid, query.Encode())
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for data catalog: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for data catalog of project %s: %w", projectUuid, err)
+	}
+
+	response := ListDataCatalogFieldsV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling data catalog response: %w", err)
+	}
+
+	// Tables are only returned when the type filter is not applied, but they are skipped just in case
+	fields := []ListDataCatalogFieldsV1Results{}
+	for _, result := range response.Results {
+		if result.Type == "field" {
+			fields = append(fields, result)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ListOrganizationWarehouseCredentialsV1Results struct {
+	OrganizationWarehouseCredentialsUUID string  `json:"organizationWarehouseCredentialsUuid"`
+	OrganizationUUID                     string  `json:"organizationUuid"`
+	Name                                 string  `json:"name"`
+	Description                          *string `json:"description,omitempty"`
+	WarehouseType                        string  `json:"warehouseType"`
+}
+
+type ListOrganizationWarehouseCredentialsV1Response struct {
+	Results []ListOrganizationWarehouseCredentialsV1Results `json:"results,omitempty"`
+	Status  string                                          `json:"status"`
+}
+
+// ListOrganizationWarehouseCredentialsV1 lists the warehouse credentials shared in the organization.
+// The secrets of the credentials are not returned.
+func ListOrganizationWarehouseCredentialsV1(c *api.Client) ([]ListOrganizationWarehouseCredentialsV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/org/warehouse-credentials", c.HostUrl)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for organization warehouse credentials: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for organization warehouse credentials: %w", err)
+	}
+
+	response := ListOrganizationWarehouseCredentialsV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response for organization warehouse credentials: %w", err)
+	}
+
+	return response.Results, nil
+}
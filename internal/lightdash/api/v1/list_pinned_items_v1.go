@@ -0,0 +1,63 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type PinnedItemV1Data struct {
+	UUID            string `json:"uuid"`
+	Name            string `json:"name"`
+	PinnedListUUID  string `json:"pinnedListUuid"`
+	PinnedListOrder int    `json:"pinnedListOrder"`
+}
+
+type PinnedItemV1 struct {
+	// Type is either "chart", "dashboard" or "space"
+	Type string           `json:"type"`
+	Data PinnedItemV1Data `json:"data"`
+}
+
+type ListPinnedItemsV1Response struct {
+	Results []PinnedItemV1 `json:"results,omitempty"`
+	Status  string         `json:"status"`
+}
+
+// ListPinnedItemsV1 lists the items pinned to the homepage of a project
+func ListPinnedItemsV1(c *api.Client, projectUuid string, pinnedListUuid string) ([]PinnedItemV1, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/pinned-lists/%s/items", c.HostUrl, projectUuid, pinnedListUuid)
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request for pinned items: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing request for pinned items: %w", err)
+	}
+
+	response := ListPinnedItemsV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling pinned items response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// schedulersPageSize is the number of schedulers requested per page
+const schedulersPageSize = 100
+
+// ListSchedulersInProjectV1Target is a delivery target of a scheduler.
+// Only the UUID field of its type is set.
+type ListSchedulersInProjectV1Target struct {
+	SchedulerEmailTargetUUID   *string `json:"schedulerEmailTargetUuid,omitempty"`
+	SchedulerSlackTargetUUID   *string `json:"schedulerSlackTargetUuid,omitempty"`
+	SchedulerMsTeamsTargetUUID *string `json:"schedulerMsTeamsTargetUuid,omitempty"`
+}
+
+type ListSchedulersInProjectV1Results struct {
+	SchedulerUUID string                            `json:"schedulerUuid"`
+	Name          string                            `json:"name"`
+	Cron          string                            `json:"cron"`
+	Format        string                            `json:"format"`
+	Targets       []ListSchedulersInProjectV1Target `json:"targets,omitempty"`
+}
+
+type ListSchedulersInProjectV1Response struct {
+	Results struct {
+		Data       []ListSchedulersInProjectV1Results `json:"data"`
+		Pagination *struct {
+			Page           float64 `json:"page"`
+			TotalPageCount float64 `json:"totalPageCount"`
+		} `json:"pagination,omitempty"`
+	} `json:"results"`
+	Status string `json:"status"`
+}
+
+// ListSchedulersInProjectV1 lists the scheduled deliveries of the charts and dashboards of a project.
+// All the pages of the list are fetched.
+func ListSchedulersInProjectV1(c *api.Client, projectUuid string) ([]ListSchedulersInProjectV1Results, error) {
+	schedulers := []ListSchedulersInProjectV1Results{}
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("%s/api/v1/projects/%s/schedulers?page=%d&pageSize=%d", c.HostUrl, projectUuid, page, schedulersPageSize)
+		req, err := http.NewRequest("GET", path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating new request for schedulers: %w", err)
+		}
+
+		body, err := c.DoRequest(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing request for schedulers of project %s: %w", projectUuid, err)
+		}
+
+		response := ListSchedulersInProjectV1Response{}
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling schedulers response: %w", err)
+		}
+		schedulers = append(schedulers, response.Results.Data...)
+
+		// The last page is reached when the pagination is missing or exhausted
+		pagination := response.Results.Pagination
+		if pagination == nil || float64(page) >= pagination.TotalPageCount {
+			return schedulers, nil
+		}
+	}
+}
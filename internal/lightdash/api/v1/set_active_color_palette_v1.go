@@ -0,0 +1,58 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type SetActiveColorPaletteV1Response struct {
+	Results interface{} `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+// SetActiveColorPaletteV1 makes the color palette the default palette of the charts of the organization
+func SetActiveColorPaletteV1(c *api.Client, colorPaletteUuid string) error {
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/org/color-palettes/%s/active", c.HostUrl, colorPaletteUuid)
+	req, err := http.NewRequest("POST", path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create new request to set the active color palette: %w", err)
+	}
+
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("request to set the active color palette (%s) failed: %w", colorPaletteUuid, err)
+	}
+
+	// Unmarshal the response
+	response := SetActiveColorPaletteV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response to set the active color palette: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
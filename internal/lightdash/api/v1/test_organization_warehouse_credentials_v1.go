@@ -0,0 +1,64 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type TestOrganizationWarehouseCredentialsV1Results struct {
+	Success bool
+	Message string
+}
+
+type testOrganizationWarehouseCredentialsV1ErrorResponse struct {
+	Error struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// TestOrganizationWarehouseCredentialsV1 tests the connection to the warehouse of organization warehouse credentials.
+// Nothing is created in Lightdash. A connection rejected by the warehouse is a result rather than an error,
+// with the message returned by Lightdash.
+func TestOrganizationWarehouseCredentialsV1(c *api.Client, organizationWarehouseCredentialsUuid string) (*TestOrganizationWarehouseCredentialsV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/org/warehouse-credentials/%s/test", c.HostUrl, organizationWarehouseCredentialsUuid)
+	req, err := http.NewRequest("POST", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new request to test warehouse credentials: %w", err)
+	}
+
+	_, err = c.DoRequest(req)
+	if err != nil {
+		// Lightdash rejects the request when it can't connect to the warehouse with the credentials
+		var statusError *api.StatusError
+		if errors.As(err, &statusError) && (statusError.StatusCode == http.StatusBadRequest || statusError.StatusCode == http.StatusUnprocessableEntity) {
+			response := testOrganizationWarehouseCredentialsV1ErrorResponse{}
+			message := string(statusError.Body)
+			if json.Unmarshal(statusError.Body, &response) == nil && response.Error.Message != "" {
+				message = response.Error.Message
+			}
+			return &TestOrganizationWarehouseCredentialsV1Results{Success: false, Message: message}, nil
+		}
+		return nil, fmt.Errorf("error performing request to test warehouse credentials %s: %w", organizationWarehouseCredentialsUuid, err)
+	}
+
+	return &TestOrganizationWarehouseCredentialsV1Results{Success: true, Message: "Connected to the warehouse"}, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+func TestTestOrganizationWarehouseCredentialsV1(t *testing.T) {
+	tests := []struct {
+		name            string
+		statusCode      int
+		body            string
+		expectError     bool
+		expectedSuccess bool
+		expectedMessage string
+	}{
+		{
+			name:            "Test with a successful connection",
+			statusCode:      http.StatusOK,
+			body:            `{"status": "ok"}`,
+			expectedSuccess: true,
+			expectedMessage: "Connected to the warehouse",
+		},
+		{
+			name:            "Test with a rejected connection",
+			statusCode:      http.StatusBadRequest,
+			body:            `{"status": "error", "error": {"name": "WarehouseConnectionError", "message": "Access Denied: Project analytics"}}`,
+			expectedMessage: "Access Denied: Project analytics",
+		},
+		{
+			name:        "Test with missing permissions",
+			statusCode:  http.StatusForbidden,
+			body:        `{"status": "error", "error": {"name": "ForbiddenError", "message": "Forbidden"}}`,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/api/v1/org/warehouse-credentials/credentials-uuid/test" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			results, err := TestOrganizationWarehouseCredentialsV1(client, "credentials-uuid")
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected an error for %s, got none", test.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %s: %v", test.name, err)
+			}
+			if results.Success != test.expectedSuccess || results.Message != test.expectedMessage {
+				t.Errorf("Expected success %v with message %q for %s, got %v with %q", test.expectedSuccess, test.expectedMessage, test.name, results.Success, results.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdateMyOrganizationV1Request struct {
+	Name               *string  `json:"name,omitempty"`
+	ChartColors        []string `json:"chartColors,omitempty"`
+	DefaultProjectUUID *string  `json:"defaultProjectUuid,omitempty"`
+}
+
+type UpdateMyOrganizationV1Response struct {
+	Results interface{} `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+func UpdateMyOrganizationV1(c *api.Client, data *UpdateMyOrganizationV1Request) error {
+	// Marshal the request body
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request to update organization: %w", err)
+	}
+
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/org", c.HostUrl)
+	req, err := http.NewRequest("PATCH", path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create request to update organization: %w", err)
+	}
+
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("request to update organization failed: %w", err)
+	}
+
+	// Unmarshal the response
+	response := UpdateMyOrganizationV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response to update organization: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
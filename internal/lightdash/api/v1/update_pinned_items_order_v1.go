@@ -0,0 +1,66 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdatePinnedItemsOrderV1Response struct {
+	Results interface{} `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+// UpdatePinnedItemsOrderV1 reorders the items pinned to the homepage of a project.
+// The items are ordered by their pinnedListOrder.
+func UpdatePinnedItemsOrderV1(c *api.Client, projectUuid string, pinnedListUuid string, items []PinnedItemV1) error {
+	// Marshal the request body
+	marshalled, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request to reorder pinned items: %w", err)
+	}
+
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/projects/%s/pinned-lists/%s/items/order", c.HostUrl, projectUuid, pinnedListUuid)
+	req, err := http.NewRequest("PATCH", path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create new request to reorder pinned items: %w", err)
+	}
+
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("request to reorder pinned items of project (%s) failed: %w", projectUuid, err)
+	}
+
+	// Unmarshal the response
+	response := UpdatePinnedItemsOrderV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response to reorder pinned items: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
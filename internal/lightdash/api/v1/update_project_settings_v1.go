@@ -0,0 +1,69 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdateProjectSettingsV1Request struct {
+	QueryRowLimit *int64 `json:"queryRowLimit,omitempty"`
+}
+
+type UpdateProjectSettingsV1Response struct {
+	Results interface{} `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+// UpdateProjectSettingsV1 updates the settings of a project. The settings which are nil are left unchanged.
+func UpdateProjectSettingsV1(c *api.Client, projectUuid string, settings UpdateProjectSettingsV1Request) error {
+	// Marshal the request body
+	marshalled, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal project settings: %w", err)
+	}
+
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/projects/%s/settings", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("PATCH", path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create new request to update project settings: %w", err)
+	}
+
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("request to update settings of project (%s) failed: %w", projectUuid, err)
+	}
+
+	// Unmarshal the response
+	response := UpdateProjectSettingsV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response to update project settings: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// updateProjectMaxAttempts bounds the number of attempts when the update conflicts with another one
+const updateProjectMaxAttempts = 3
+
+// updateProjectConflictBackoff is the base delay between two conflicting attempts
+var updateProjectConflictBackoff = 2 * time.Second
+
+type UpdateProjectV1Response struct {
+	Results interface{} `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+// UpdateProjectV1 updates a project with optimistic concurrency.
+// The request body is built from the current project, which is re-read after each 409 conflict
+// so that the update is applied on top of the changes made by a concurrent update.
+func UpdateProjectV1(ctx context.Context, c *api.Client, projectUuid string, buildUpdate func(current *GetProjectV1Results) (*models.UpdateProject, error)) error {
+	var lastErr error
+	for attempt := 1; attempt <= updateProjectMaxAttempts; attempt++ {
+		// Re-read the current project
+		current, err := GetProjectV1(ctx, c, projectUuid)
+		if err != nil {
+			return fmt.Errorf("failed to get project (%s) before updating it: %w", projectUuid, err)
+		}
+		update, err := buildUpdate(current)
+		if err != nil {
+			return fmt.Errorf("failed to build the update of project (%s): %w", projectUuid, err)
+		}
+
+		lastErr = updateProjectV1(ctx, c, projectUuid, update)
+		if lastErr == nil {
+			return nil
+		}
+		if statusCode, _ := api.StatusCodeOf(lastErr); statusCode != http.StatusConflict {
+			return lastErr
+		}
+
+		// Wait for the concurrent update to finish
+		if attempt < updateProjectMaxAttempts {
+			if err := api.WaitForRetry(ctx, time.Duration(attempt)*updateProjectConflictBackoff); err != nil {
+				return fmt.Errorf("stopped retrying the conflicting update of project (%s): %w", projectUuid, err)
+			}
+		}
+	}
+	return fmt.Errorf("project (%s) update still conflicts after %d attempts: %w", projectUuid, updateProjectMaxAttempts, lastErr)
+}
+
+func updateProjectV1(ctx context.Context, c *api.Client, projectUuid string, update *models.UpdateProject) error {
+	// Marshal the request body
+	marshalled, err := json.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request to update project: %w", err)
+	}
+
+	// Create the request
+	path := fmt.Sprintf("%s/api/v1/projects/%s", c.HostUrl, projectUuid)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create new request to update project: %w", err)
+	}
+
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("request to update project (%s) failed: %w", projectUuid, err)
+	}
+
+	// Unmarshal the response
+	response := UpdateProjectV1Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response to update project: %w", err)
+	}
+
+	// Validate the response status
+	if response.Status != "ok" {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestUpdateProjectV1(t *testing.T) {
+	updateProjectConflictBackoff = 0
+
+	tests := []struct {
+		name             string
+		conflicts        int
+		expectedAttempts int
+		expectError      bool
+	}{
+		{
+			name:             "Test without conflict",
+			conflicts:        0,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "Test with a transient conflict",
+			conflicts:        2,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "Test with a persistent conflict",
+			conflicts:        updateProjectMaxAttempts,
+			expectedAttempts: updateProjectMaxAttempts,
+			expectError:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reads := 0
+			updates := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					reads++
+					_, _ = fmt.Fprintf(w, `{"status": "ok", "results": {"projectUuid": "project-uuid", "name": "Project %d"}}`, reads)
+				case "PATCH":
+					updates++
+					if updates <= test.conflicts {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"status": "error"}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"status": "ok"}`))
+				}
+			}))
+			defer server.Close()
+
+			client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			builtFrom := []string{}
+			err := UpdateProjectV1(context.Background(), client, "project-uuid", func(current *GetProjectV1Results) (*models.UpdateProject, error) {
+				builtFrom = append(builtFrom, current.ProjectName)
+				return &models.UpdateProject{Name: "Updated", DbtVersion: "v1.10"}, nil
+			})
+			if test.expectError && err == nil {
+				t.Errorf("Expected an error for %s, got none", test.name)
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("Unexpected error for %s: %v", test.name, err)
+			}
+			if updates != test.expectedAttempts {
+				t.Errorf("Expected %d update attempts for %s, got %d", test.expectedAttempts, test.name, updates)
+			}
+			// The update is rebuilt from a fresh read of the project on each attempt
+			if len(builtFrom) != test.expectedAttempts || builtFrom[len(builtFrom)-1] != fmt.Sprintf("Project %d", test.expectedAttempts) {
+				t.Errorf("Expected the update to be rebuilt from each read for %s, got %v", test.name, builtFrom)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectV1Canceled(t *testing.T) {
+	updateProjectConflictBackoff = time.Hour
+	defer func() { updateProjectConflictBackoff = 0 }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": {"projectUuid": "project-uuid", "name": "Project"}}`))
+		case "PATCH":
+			updates++
+			// Cancel while the update conflicts, so that the backoff is interrupted
+			cancel()
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"status": "error"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+	err := UpdateProjectV1(ctx, client, "project-uuid", func(current *GetProjectV1Results) (*models.UpdateProject, error) {
+		return &models.UpdateProject{Name: "Updated", DbtVersion: "v1.10"}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the update to stop on cancellation, got %v", err)
+	}
+	if updates != 1 {
+		t.Errorf("Expected 1 update attempt, got %d", updates)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestUpdateRefreshSchedulerV1(t *testing.T) {
+	tests := []struct {
+		name         string
+		scheduler    *models.ProjectRefreshScheduler
+		response     string
+		expectedBody string
+		expectError  bool
+	}{
+		{
+			name:         "Test with an enabled schedule",
+			scheduler:    &models.ProjectRefreshScheduler{Cron: "0 6 * * *", Enabled: true},
+			response:     `{"status": "ok"}`,
+			expectedBody: `{"cron":"0 6 * * *","enabled":true}`,
+		},
+		{
+			name:         "Test with a paused schedule",
+			scheduler:    &models.ProjectRefreshScheduler{Cron: "*/30 * * * *", Enabled: false},
+			response:     `{"status": "ok"}`,
+			expectedBody: `{"cron":"*/30 * * * *","enabled":false}`,
+		},
+		{
+			name:         "Test with an unexpected status",
+			scheduler:    &models.ProjectRefreshScheduler{Cron: "0 6 * * *", Enabled: true},
+			response:     `{"status": "error"}`,
+			expectedBody: `{"cron":"0 6 * * *","enabled":true}`,
+			expectError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PATCH" || r.URL.Path != "/api/v1/projects/project-uuid/refresh-scheduler" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				requestBody, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read the request body: %v", err)
+				}
+				body = string(requestBody)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			err := UpdateRefreshSchedulerV1(client, "project-uuid", test.scheduler)
+			if test.expectError && err == nil {
+				t.Errorf("Expected an error for %s, got none", test.name)
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("Unexpected error for %s: %v", test.name, err)
+			}
+			if body != test.expectedBody {
+				t.Errorf("Expected the request body %s for %s, got %s", test.expectedBody, test.name, body)
+			}
+		})
+	}
+}
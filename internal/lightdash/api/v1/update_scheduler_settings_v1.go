@@ -25,6 +25,7 @@ import (
 
 type UpdateSchedulerSettingsV1Request struct {
 	SchedulerTimezone string `json:"schedulerTimezone"`
+	SchedulerEnabled  *bool  `json:"schedulerEnabled,omitempty"`
 }
 
 type UpdateSchedulerSettingsV1Response struct {
@@ -32,10 +33,11 @@ type UpdateSchedulerSettingsV1Response struct {
 	Status  string      `json:"status"`
 }
 
-func UpdateSchedulerSettingsV1(c *api.Client, projectUuid string, schedulerTimezone string) (*UpdateSchedulerSettingsV1Response, error) {
+func UpdateSchedulerSettingsV1(c *api.Client, projectUuid string, schedulerTimezone string, schedulerEnabled *bool) (*UpdateSchedulerSettingsV1Response, error) {
 	// Create the request body
 	data := UpdateSchedulerSettingsV1Request{
 		SchedulerTimezone: schedulerTimezone,
+		SchedulerEnabled:  schedulerEnabled,
 	}
 
 	// Marshal the request body
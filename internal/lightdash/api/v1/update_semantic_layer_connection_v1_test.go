@@ -0,0 +1,96 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestUpdateSemanticLayerConnectionV1(t *testing.T) {
+	connection := &models.SemanticLayerConnection{
+		Type:          models.SemanticLayerTypeDbt,
+		EnvironmentID: "123456",
+		Domain:        models.DefaultDbtSemanticLayerDomain,
+		Token:         "dbt-token",
+	}
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		response    string
+		expectError bool
+	}{
+		{
+			name:       "Test with a connected semantic layer",
+			statusCode: http.StatusOK,
+			response:   `{"status": "ok"}`,
+		},
+		{
+			name:        "Test with an unexpected status",
+			statusCode:  http.StatusOK,
+			response:    `{"status": "error"}`,
+			expectError: true,
+		},
+		{
+			name:        "Test with a rejected connection",
+			statusCode:  http.StatusBadRequest,
+			response:    `{"status": "error", "error": {"statusCode": 400, "name": "ParameterError", "message": "Invalid token"}}`,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requested *models.SemanticLayerConnection
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PATCH" || r.URL.Path != "/api/v1/projects/project-uuid/semantic-layer-connection" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read the request body: %v", err)
+				}
+				requested = &models.SemanticLayerConnection{}
+				if err := json.Unmarshal(body, requested); err != nil {
+					t.Errorf("Failed to unmarshal the request body: %v", err)
+				}
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+			err := UpdateSemanticLayerConnectionV1(client, "project-uuid", connection)
+			if test.expectError && err == nil {
+				t.Errorf("Expected an error for %s, got none", test.name)
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("Unexpected error for %s: %v", test.name, err)
+			}
+			// The connection is sent with the token, which is never returned by the API
+			if !reflect.DeepEqual(requested, connection) {
+				t.Errorf("Expected the request body %+v, got %+v", connection, requested)
+			}
+		})
+	}
+}
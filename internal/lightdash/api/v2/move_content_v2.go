@@ -0,0 +1,65 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// ContentType is the type of a content item that can be moved between spaces
+type ContentType string
+
+const (
+	ContentTypeChart     ContentType = "chart"
+	ContentTypeDashboard ContentType = "dashboard"
+)
+
+// MoveContentV2 moves a chart or a dashboard into a space using the v2 API.
+// The request has the same shape as MoveSpaceV2Request.
+func MoveContentV2(c *api.Client, projectUuid string, contentType ContentType, contentUuid string, targetSpaceUuid string) error {
+	data := MoveSpaceV2Request{}
+	data.Item.UUID = contentUuid
+	data.Item.Type = string(contentType)
+	data.Item.ContentType = string(contentType)
+	data.Action.Type = "move"
+	data.Action.TargetSpaceUUID = &targetSpaceUuid
+
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal, MoveContentV2(projectUuid=%s, contentType=%s, contentUuid=%s, targetSpaceUuid=%s): %w", projectUuid, contentType, contentUuid, targetSpaceUuid, err)
+	}
+	path := fmt.Sprintf("%s/api/v2/content/%s/move", c.HostUrl, projectUuid)
+	req, err := http.NewRequest("POST", path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create new request, MoveContentV2(projectUuid=%s, contentType=%s, contentUuid=%s, targetSpaceUuid=%s): %w", projectUuid, contentType, contentUuid, targetSpaceUuid, err)
+	}
+	// Do request
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to do request, MoveContentV2(projectUuid=%s, contentType=%s, contentUuid=%s, targetSpaceUuid=%s): %w", projectUuid, contentType, contentUuid, targetSpaceUuid, err)
+	}
+	// Marshal the response
+	response := MoveSpaceV2Response{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response, MoveContentV2(projectUuid=%s, contentType=%s, contentUuid=%s, targetSpaceUuid=%s): %w", projectUuid, contentType, contentUuid, targetSpaceUuid, err)
+	}
+	return nil
+}
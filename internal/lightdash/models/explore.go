@@ -0,0 +1,34 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// Explore represents a summary of a compiled explore in a Lightdash project
+type Explore struct {
+	Name         string   `json:"name"`
+	Label        string   `json:"label"`
+	Tags         []string `json:"tags"`
+	GroupLabel   *string  `json:"groupLabel,omitempty"`
+	DatabaseName *string  `json:"databaseName,omitempty"`
+	SchemaName   *string  `json:"schemaName,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	// Errors are set when the model of the explore failed to compile
+	Errors []ExploreError `json:"errors,omitempty"`
+}
+
+// ExploreError describes why the model of an explore failed to compile
+type ExploreError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
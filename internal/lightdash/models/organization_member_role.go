@@ -44,3 +44,12 @@ func (e OrganizationMemberRole) IsValid() bool {
 	}
 	return false
 }
+
+// ProjectRole returns the role that the organization role grants on every project of the organization.
+// A plain member has no access to the projects.
+func (e OrganizationMemberRole) ProjectRole() (ProjectMemberRole, bool) {
+	if e == ORGANIZATION_MEMBER_ROLE || !e.IsValid() {
+		return "", false
+	}
+	return ProjectMemberRole(e), true
+}
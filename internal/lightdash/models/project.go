@@ -14,28 +14,136 @@
 
 package models
 
+import (
+	"strconv"
+	"strings"
+)
+
 // DbtProjectType represents the type of dbt project connection
 type DbtProjectType string
 
 const (
-	DbtProjectTypeGithub DbtProjectType = "github"
-	DbtProjectTypeGitlab DbtProjectType = "gitlab"
-	DbtProjectTypeDbt    DbtProjectType = "dbt"
+	DbtProjectTypeGithub      DbtProjectType = "github"
+	DbtProjectTypeGitlab      DbtProjectType = "gitlab"
+	DbtProjectTypeDbt         DbtProjectType = "dbt"
+	DbtProjectTypeBitbucket   DbtProjectType = "bitbucket"
+	DbtProjectTypeAzureDevops DbtProjectType = "azure_devops"
+	DbtProjectTypeDbtCloudIDE DbtProjectType = "dbt_cloud_ide"
+	DbtProjectTypeNone        DbtProjectType = "none"
 )
 
-// DbtGithubProjectConfig represents GitHub dbt project configuration
+// SupportedDbtProjectTypes lists the dbt connection types known by Lightdash
+var SupportedDbtProjectTypes = []DbtProjectType{
+	DbtProjectTypeGithub,
+	DbtProjectTypeGitlab,
+	DbtProjectTypeDbt,
+	DbtProjectTypeBitbucket,
+	DbtProjectTypeAzureDevops,
+	DbtProjectTypeDbtCloudIDE,
+	DbtProjectTypeNone,
+}
+
+// DbtVersionLatest is the alias that resolves to the newest supported dbt version
+const DbtVersionLatest = "latest"
+
+// DbtVersionAuto asks the server to detect the dbt version from the dbt project of the repository.
+// Not every Lightdash server supports it.
+const DbtVersionAuto = "auto"
+
+// SupportedDbtVersions lists the dbt versions supported by Lightdash, from oldest to newest
+var SupportedDbtVersions = []string{
+	"v1.4",
+	"v1.5",
+	"v1.6",
+	"v1.7",
+	"v1.8",
+	"v1.9",
+	"v1.10",
+}
+
+// ResolveDbtVersion resolves the "latest" alias to the newest supported dbt version.
+// Any other value is returned as is.
+func ResolveDbtVersion(dbtVersion string) string {
+	if dbtVersion == DbtVersionLatest {
+		return SupportedDbtVersions[len(SupportedDbtVersions)-1]
+	}
+	return dbtVersion
+}
+
+// ResolveDbtVersionFrom resolves the "latest" alias to the newest of the given dbt versions, e.g. those reported by the server.
+// SupportedDbtVersions is used as a fallback when no version is given.
+func ResolveDbtVersionFrom(dbtVersion string, supportedDbtVersions []string) string {
+	if dbtVersion != DbtVersionLatest || len(supportedDbtVersions) == 0 {
+		return ResolveDbtVersion(dbtVersion)
+	}
+	newest := supportedDbtVersions[0]
+	for _, supportedDbtVersion := range supportedDbtVersions[1:] {
+		if compareDbtVersions(supportedDbtVersion, newest) > 0 {
+			newest = supportedDbtVersion
+		}
+	}
+	return newest
+}
+
+// compareDbtVersions compares dbt versions such as "v1.9" and "v1.10" by their numeric parts.
+// It returns a negative number when a is older than b, and a positive number when a is newer.
+func compareDbtVersions(a string, b string) int {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return 0
+}
+
+// DbtGithubProjectConfig represents GitHub dbt project configuration.
+// The repository fields are left empty for the "dbt" and "none" connection types, which have no git repository.
+// Those CLI-deployed projects locate the dbt project with ProjectDir instead.
 type DbtGithubProjectConfig struct {
-	Type                DbtProjectType `json:"type"`
-	AuthorizationMethod string         `json:"authorization_method"` // "personal_access_token" or "installation_id"
-	PersonalAccessToken *string        `json:"personal_access_token,omitempty"`
-	InstallationID      *string        `json:"installation_id,omitempty"`
-	Repository          string         `json:"repository"`
-	Branch              string         `json:"branch"`
-	ProjectSubPath      string         `json:"project_sub_path"`
-	HostDomain          *string        `json:"host_domain,omitempty"`
-	Target              *string        `json:"target,omitempty"`
-	Environment         []interface{}  `json:"environment,omitempty"`
-	Selector            *string        `json:"selector,omitempty"`
+	Type                DbtProjectType                  `json:"type"`
+	AuthorizationMethod string                          `json:"authorization_method,omitempty"` // "personal_access_token" or "installation_id"
+	PersonalAccessToken *string                         `json:"personal_access_token,omitempty"`
+	InstallationID      *string                         `json:"installation_id,omitempty"`
+	Repository          string                          `json:"repository,omitempty"`
+	Branch              string                          `json:"branch,omitempty"`
+	ProjectSubPath      string                          `json:"project_sub_path,omitempty"`
+	ProjectDir          *string                         `json:"project_dir,omitempty"`
+	HostDomain          *string                         `json:"host_domain,omitempty"`
+	Target              *string                         `json:"target,omitempty"`
+	Environment         []DbtProjectEnvironmentVariable `json:"environment,omitempty"`
+	Selector            *string                         `json:"selector,omitempty"`
+	// EnvironmentID and APIKey connect a "dbt_cloud_ide" project to a dbt Cloud environment
+	EnvironmentID *string `json:"environment_id,omitempty"`
+	APIKey        *string `json:"api_key,omitempty"`
+}
+
+// DbtProjectEnvironmentVariable represents an environment variable of the dbt project, which may hold a secret
+type DbtProjectEnvironmentVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EnvironmentValues returns the values of the environment variables, which must be masked in logs and errors
+func (c *DbtGithubProjectConfig) EnvironmentValues() []string {
+	if c == nil {
+		return nil
+	}
+	values := make([]string, 0, len(c.Environment))
+	for _, variable := range c.Environment {
+		if variable.Value != "" {
+			values = append(values, variable.Value)
+		}
+	}
+	return values
 }
 
 // Project represents a Lightdash project
@@ -63,4 +171,21 @@ type CreateProject struct {
 	WarehouseConnection                        *BigQueryCredentials    `json:"warehouseConnection,omitempty"`
 	UpstreamProjectUUID                        *string                 `json:"upstreamProjectUuid,omitempty"`
 	CopyWarehouseConnectionFromUpstreamProject *bool                   `json:"copyWarehouseConnectionFromUpstreamProject,omitempty"`
+	ContentCopySelector                        *ContentCopySelector    `json:"contentCopySelector,omitempty"`
+	CopyContent                                *bool                   `json:"copyContent,omitempty"`
+}
+
+// UpdateProject represents the request body for updating a project
+type UpdateProject struct {
+	Name                                 string                  `json:"name"`
+	DbtVersion                           string                  `json:"dbtVersion"`
+	DbtConnection                        *DbtGithubProjectConfig `json:"dbtConnection,omitempty"`
+	OrganizationWarehouseCredentialsUUID *string                 `json:"organizationWarehouseCredentialsUuid,omitempty"`
+	WarehouseConnection                  *BigQueryCredentials    `json:"warehouseConnection,omitempty"`
+}
+
+// ContentCopySelector selects the content copied from the upstream project into a preview project
+type ContentCopySelector struct {
+	SpaceUUIDs     []string `json:"spaceUuids,omitempty"`
+	DashboardUUIDs []string `json:"dashboardUuids,omitempty"`
 }
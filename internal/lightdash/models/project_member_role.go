@@ -42,3 +42,21 @@ func (s ProjectMemberRole) IsValid() bool {
 	}
 	return false
 }
+
+// Rank orders the roles by the permissions they grant, from 1 for a viewer to 5 for an admin.
+// It is 0 for an invalid role.
+func (s ProjectMemberRole) Rank() int {
+	switch s {
+	case PROJECT_VIEWER_ROLE:
+		return 1
+	case PROJECT_INTERACTIVE_VIEWER_ROLE:
+		return 2
+	case PROJECT_EDITOR_ROLE:
+		return 3
+	case PROJECT_DEVELOPER_ROLE:
+		return 4
+	case PROJECT_ADMIN_ROLE:
+		return 5
+	}
+	return 0
+}
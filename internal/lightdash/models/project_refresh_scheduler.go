@@ -0,0 +1,22 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// ProjectRefreshScheduler represents the schedule on which Lightdash re-syncs the dbt project of a project
+type ProjectRefreshScheduler struct {
+	// Cron is a 5-field cron expression evaluated in the scheduler timezone of the project
+	Cron    string `json:"cron"`
+	Enabled bool   `json:"enabled"`
+}
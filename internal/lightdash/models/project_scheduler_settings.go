@@ -20,6 +20,7 @@ const DefaultProjectSchedulerTimezone = "UTC"
 // SchedulerSettings represents the scheduler settings for a project
 type ProjectSchedulerSettings struct {
 	SchedulerTimezone string `json:"schedulerTimezone" validate:"required"`
+	SchedulerEnabled  *bool  `json:"schedulerEnabled,omitempty"`
 }
 
 // GetSchedulerTimezone returns the scheduler timezone for the project
@@ -30,3 +31,12 @@ func (s *ProjectSchedulerSettings) GetSchedulerTimezone() string {
 	}
 	return s.SchedulerTimezone
 }
+
+// IsSchedulerEnabled returns whether the scheduled deliveries of the project are enabled
+func (s *ProjectSchedulerSettings) IsSchedulerEnabled() bool {
+	// The scheduler is enabled unless it is explicitly disabled
+	if s.SchedulerEnabled == nil {
+		return true
+	}
+	return *s.SchedulerEnabled
+}
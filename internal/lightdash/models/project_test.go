@@ -0,0 +1,56 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestResolveDbtVersion(t *testing.T) {
+	tests := []struct {
+		dbtVersion string
+		expected   string
+	}{
+		{DbtVersionLatest, SupportedDbtVersions[len(SupportedDbtVersions)-1]},
+		{"v1.8", "v1.8"},
+		{"v1.10", "v1.10"},
+	}
+
+	for _, test := range tests {
+		if actual := ResolveDbtVersion(test.dbtVersion); actual != test.expected {
+			t.Errorf("Expected %s for dbt version %s, got %s", test.expected, test.dbtVersion, actual)
+		}
+	}
+}
+
+func TestResolveDbtVersionFrom(t *testing.T) {
+	tests := []struct {
+		dbtVersion           string
+		supportedDbtVersions []string
+		expected             string
+	}{
+		{DbtVersionLatest, []string{"v1.9", "v1.11", "v1.10"}, "v1.11"},
+		{DbtVersionLatest, []string{"v1.10", "v1.9"}, "v1.10"},
+		{DbtVersionLatest, nil, SupportedDbtVersions[len(SupportedDbtVersions)-1]},
+		{"v1.8", []string{"v1.11"}, "v1.8"},
+		{DbtVersionAuto, []string{"v1.11"}, DbtVersionAuto},
+	}
+
+	for _, test := range tests {
+		if actual := ResolveDbtVersionFrom(test.dbtVersion, test.supportedDbtVersions); actual != test.expected {
+			t.Errorf("Expected %s for dbt version %s with %v, got %s", test.expected, test.dbtVersion, test.supportedDbtVersions, actual)
+		}
+	}
+}
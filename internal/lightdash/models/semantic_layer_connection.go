@@ -0,0 +1,33 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// SemanticLayerType represents the type of a semantic layer connection
+type SemanticLayerType string
+
+const (
+	SemanticLayerTypeDbt SemanticLayerType = "DBT"
+)
+
+// DefaultDbtSemanticLayerDomain is the domain of the dbt Cloud semantic layer API in the US multi-tenant region
+const DefaultDbtSemanticLayerDomain = "https://semantic-layer.cloud.getdbt.com"
+
+// SemanticLayerConnection represents the connection of a project to a dbt Cloud semantic layer
+type SemanticLayerConnection struct {
+	Type          SemanticLayerType `json:"type"`
+	EnvironmentID string            `json:"environmentId"`
+	Domain        string            `json:"domain"`
+	Token         string            `json:"token,omitempty"`
+}
@@ -14,7 +14,11 @@
 
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 type CredentialsDetail struct {
 	Type string `json:"type"`
@@ -34,6 +38,7 @@ type BigQueryCredentials struct {
 	Priority                  *string                `json:"priority,omitempty"`
 	Retries                   *int                   `json:"retries,omitempty"`
 	StartOfWeek               *int                   `json:"startOfWeek,omitempty"`
+	Threads                   *int                   `json:"threads,omitempty"`
 	RequireUserCredentials    *bool                  `json:"requireUserCredentials,omitempty"`
 	OrganizationWarehouseUUID *string                `json:"organizationWarehouseCredentialsUuid,omitempty"`
 }
@@ -51,3 +56,37 @@ type WarehouseCredentials struct {
 	UserUUID                  string      `json:"userUuid,omitempty"`
 	UUID                      string      `json:"uuid,omitempty"` // Deprecated: use OrganizationWarehouseUUID
 }
+
+// WarehouseConnection is the warehouse connection of a project as returned by the API.
+// The fields depend on the warehouse type, so they are decoded once the type is known.
+type WarehouseConnection struct {
+	Type WarehouseType
+	raw  json.RawMessage
+}
+
+func (w *WarehouseConnection) UnmarshalJSON(data []byte) error {
+	var typed struct {
+		Type WarehouseType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+	w.Type = typed.Type
+	w.raw = append(json.RawMessage{}, data...)
+	return nil
+}
+
+func (w WarehouseConnection) MarshalJSON() ([]byte, error) {
+	if w.raw == nil {
+		return []byte("null"), nil
+	}
+	return w.raw, nil
+}
+
+// Decode decodes the fields of the warehouse connection into the credentials of its warehouse type
+func (w *WarehouseConnection) Decode(credentials interface{}) error {
+	if err := json.Unmarshal(w.raw, credentials); err != nil {
+		return fmt.Errorf("failed to decode %s warehouse connection: %w", w.Type, err)
+	}
+	return nil
+}
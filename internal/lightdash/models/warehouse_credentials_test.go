@@ -0,0 +1,51 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWarehouseConnectionDecode(t *testing.T) {
+	data := []byte(`{"type":"bigquery","project":"my-project","dataset":"analytics","maximumBytesBilled":1000}`)
+
+	connection := WarehouseConnection{}
+	if err := json.Unmarshal(data, &connection); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if connection.Type != WarehouseTypeBigQuery {
+		t.Errorf("Type = %s, want %s", connection.Type, WarehouseTypeBigQuery)
+	}
+
+	credentials := BigQueryCredentials{}
+	if err := connection.Decode(&credentials); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if credentials.Project != "my-project" || credentials.Dataset == nil || *credentials.Dataset != "analytics" {
+		t.Errorf("Decode() = %+v, want project my-project and dataset analytics", credentials)
+	}
+	if credentials.MaximumBytesBilled == nil || *credentials.MaximumBytesBilled != 1000 {
+		t.Errorf("MaximumBytesBilled = %v, want 1000", credentials.MaximumBytesBilled)
+	}
+
+	marshalled, err := json.Marshal(connection)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(marshalled) != string(data) {
+		t.Errorf("json.Marshal() = %s, want %s", marshalled, data)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// WarehouseType represents the type of a warehouse connection
+type WarehouseType string
+
+// List of WarehouseType
+const (
+	WarehouseTypeBigQuery   WarehouseType = "bigquery"
+	WarehouseTypePostgres   WarehouseType = "postgres"
+	WarehouseTypeRedshift   WarehouseType = "redshift"
+	WarehouseTypeSnowflake  WarehouseType = "snowflake"
+	WarehouseTypeDatabricks WarehouseType = "databricks"
+	WarehouseTypeTrino      WarehouseType = "trino"
+	WarehouseTypeClickhouse WarehouseType = "clickhouse"
+	WarehouseTypeAthena     WarehouseType = "athena"
+)
+
+// SupportedWarehouseTypes lists the warehouse types known by Lightdash
+var SupportedWarehouseTypes = []WarehouseType{
+	WarehouseTypeBigQuery,
+	WarehouseTypePostgres,
+	WarehouseTypeRedshift,
+	WarehouseTypeSnowflake,
+	WarehouseTypeDatabricks,
+	WarehouseTypeTrino,
+	WarehouseTypeClickhouse,
+	WarehouseTypeAthena,
+}
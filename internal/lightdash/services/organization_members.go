@@ -52,7 +52,11 @@ func GetOrganizationMembersService(client *api.Client) *OrganizationMembersServi
 }
 
 // Fetch the members from the organization using the API client
+// All the pages are fetched within the deadline of the context, or api.DefaultListTimeout when it has none.
 func (s *OrganizationMembersService) GetOrganizationMembers(ctx context.Context) ([]apiv1.GetOrganizationMembersV1Results, error) {
+	ctx, cancel := api.WithListTimeout(ctx)
+	defer cancel()
+
 	pageSize := 100
 	members := []apiv1.GetOrganizationMembersV1Results{}
 
@@ -60,7 +64,7 @@ func (s *OrganizationMembersService) GetOrganizationMembers(ctx context.Context)
 	page := 0
 	for {
 		// Fetch the members from the organization using the API client
-		pageMembers, err := apiv1.GetOrganizationMembersV1(s.client, 0, pageSize, page, "")
+		pageMembers, err := apiv1.GetOrganizationMembersV1(ctx, s.client, 0, pageSize, page, "")
 		if err != nil {
 			return nil, err
 		}
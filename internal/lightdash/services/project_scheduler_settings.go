@@ -38,7 +38,7 @@ func NewProjectSchedulerSettingsService(client *api.Client, projectUuid string)
 
 func (s *ProjectSchedulerSettingsService) GetProjectSchedulerSettings(ctx context.Context, projectUuid string) (*models.ProjectSchedulerSettings, error) {
 	// Get the project
-	project, err := apiv1.GetProjectV1(s.client, projectUuid)
+	project, err := apiv1.GetProjectV1(ctx, s.client, projectUuid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project (%s): %w", projectUuid, err)
 	}
@@ -46,6 +46,7 @@ func (s *ProjectSchedulerSettingsService) GetProjectSchedulerSettings(ctx contex
 	// Get the project scheduler settings
 	schedulerSettings := &models.ProjectSchedulerSettings{
 		SchedulerTimezone: project.SchedulerTimezone,
+		SchedulerEnabled:  project.SchedulerEnabled,
 	}
 
 	return schedulerSettings, nil
@@ -56,7 +57,7 @@ func (s *ProjectSchedulerSettingsService) UpdateProjectSchedulerSettings(
 
 	// Update the project scheduler settings
 	var schedulerTimezone = projectSchedulerSettings.SchedulerTimezone
-	_, err := apiv1.UpdateSchedulerSettingsV1(s.client, s.projectUuid, schedulerTimezone)
+	_, err := apiv1.UpdateSchedulerSettingsV1(s.client, s.projectUuid, schedulerTimezone, projectSchedulerSettings.SchedulerEnabled)
 	if err != nil {
 		return fmt.Errorf("failed to update project scheduler settings in project (%s) with timezone (%s): %w", s.projectUuid, schedulerTimezone, err)
 	}
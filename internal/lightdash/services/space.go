@@ -111,6 +111,15 @@ func (s *SpaceService) MoveSpace(ctx context.Context, projectUuid, spaceUuid str
 	return nil
 }
 
+// MoveContentToSpace moves a chart or a dashboard into a space
+func (s *SpaceService) MoveContentToSpace(ctx context.Context, projectUuid string, contentType apiv2.ContentType, contentUuid string, spaceUuid string) error {
+	err := apiv2.MoveContentV2(s.client, projectUuid, contentType, contentUuid, spaceUuid)
+	if err != nil {
+		return fmt.Errorf("failed to move %s %s to space %s: %w", contentType, contentUuid, spaceUuid, err)
+	}
+	return nil
+}
+
 // Resource ID Handling Methods
 
 // GetSpaceResourceID returns the formatted resource ID for a space
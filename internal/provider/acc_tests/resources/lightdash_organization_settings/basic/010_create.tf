@@ -0,0 +1,3 @@
+# The settings which are not set are kept, so the organization used by the tests is left unchanged
+resource "lightdash_organization_settings" "test" {
+}
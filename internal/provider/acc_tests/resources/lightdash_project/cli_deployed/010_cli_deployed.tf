@@ -0,0 +1,23 @@
+data "lightdash_organization" "test" {
+}
+
+# The dbt content of the project is deployed with `lightdash deploy`, so no git repository is configured
+resource "lightdash_project" "cli_deployed" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  name              = "CLI Deployed Project (Acceptance Test: cli_deployed)"
+  type              = "DEFAULT"
+  dbt_version       = "v1.8"
+
+  dbt_connection = {
+    type             = "dbt"
+    project_sub_path = "dbt"
+    target           = "prod"
+  }
+
+  warehouse_connection = {
+    type             = "bigquery"
+    project          = local.warehouse.project
+    dataset          = local.warehouse.dataset
+    keyfile_contents = local.warehouse.keyfile_contents
+  }
+}
@@ -0,0 +1,20 @@
+data "lightdash_organization" "test" {
+}
+
+resource "lightdash_project" "warehouse__bigquery" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  name              = "BigQuery Project (Acceptance Test: warehouses)"
+  type              = "DEFAULT"
+  dbt_version       = "v1.8"
+
+  dbt_connection = {
+    type = "none"
+  }
+
+  warehouse_connection = {
+    type             = "bigquery"
+    project          = local.warehouse.project
+    dataset          = local.warehouse.dataset
+    keyfile_contents = local.warehouse.keyfile_contents
+  }
+}
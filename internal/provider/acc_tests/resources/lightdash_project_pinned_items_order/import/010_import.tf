@@ -0,0 +1,5 @@
+resource "lightdash_project_pinned_items_order" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  # The order is imported from the project, so the listed items are not applied
+  item_uuids = []
+}
@@ -0,0 +1,5 @@
+# The test project is not connected to a dbt Cloud semantic layer, so the resource only checks that it stays disconnected
+resource "lightdash_project_semantic_layer" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  enabled      = false
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// addAPIErrorDiagnostics adds the error of a Lightdash API request to the diagnostics.
+// The fields rejected by the API are attached to the matching attributes of the plan, which points large
+// configurations to the offending attribute. The error is always attached to the whole resource too, together with
+// the rejected fields which don't match any attribute, so that no rejection is dropped.
+func addAPIErrorDiagnostics(ctx context.Context, diagnostics *diag.Diagnostics, plan tfsdk.Plan, summary string, detail string, err error) {
+	var unmatchedErrors []string
+	for _, validationError := range api.ValidationErrorsOf(err) {
+		attributePath, ok := getAttributePathOfField(ctx, plan, validationError.Field)
+		if !ok {
+			unmatchedErrors = append(unmatchedErrors, fmt.Sprintf("%s: %s", validationError.Field, validationError.Message))
+			continue
+		}
+		diagnostics.AddAttributeError(
+			attributePath,
+			summary,
+			fmt.Sprintf("The Lightdash API rejected the value of %s: %s", attributePath.String(), validationError.Message),
+		)
+	}
+
+	message := detail + err.Error()
+	if len(unmatchedErrors) > 0 {
+		message += "\n\nThe Lightdash API rejected fields which don't match any attribute:\n- " + strings.Join(unmatchedErrors, "\n- ")
+	}
+	diagnostics.AddError(summary, message)
+}
+
+// getAttributePathOfField returns the path of the attribute matching a field of a request body, e.g.
+// `warehouse_connection.dataset` for "warehouseConnection.dataset". It returns false when the schema has no such attribute.
+func getAttributePathOfField(ctx context.Context, plan tfsdk.Plan, field string) (path.Path, bool) {
+	if plan.Schema == nil || field == "" {
+		return path.Empty(), false
+	}
+
+	attributePath := path.Empty()
+	for i, segment := range strings.Split(field, ".") {
+		index, err := strconv.Atoi(segment)
+		switch {
+		case i > 0 && err == nil:
+			attributePath = attributePath.AtListIndex(index)
+		case i == 0:
+			attributePath = path.Root(camelToSnakeCase(segment))
+		default:
+			attributePath = attributePath.AtName(camelToSnakeCase(segment))
+		}
+	}
+
+	if _, diags := plan.Schema.AttributeAtPath(ctx, attributePath); diags.HasError() {
+		return path.Empty(), false
+	}
+	return attributePath, true
+}
+
+// camelToSnakeCase converts the name of a field of the Lightdash API to the name of an attribute, e.g. "dbtVersion" to "dbt_version"
+func camelToSnakeCase(name string) string {
+	var snake strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				snake.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		snake.WriteRune(r)
+	}
+	return snake.String()
+}
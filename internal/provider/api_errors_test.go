@@ -0,0 +1,157 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewProjectResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedAttributes []path.Path
+		expectedUnmatched  []string
+	}{
+		{
+			name: "matched fields",
+			err:  &api.StatusError{StatusCode: 400, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.name": {"message": "Too long"}}}}`)},
+			expectedAttributes: []path.Path{
+				path.Root("name"),
+			},
+		},
+		{
+			name: "matched and unmatched fields",
+			err:  &api.StatusError{StatusCode: 400, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.name": {"message": "Too long"}, "body.unknownField": {"message": "Not allowed"}}}}`)},
+			expectedAttributes: []path.Path{
+				path.Root("name"),
+			},
+			expectedUnmatched: []string{"unknownField: Not allowed"},
+		},
+		{
+			name:              "unmatched fields",
+			err:               &api.StatusError{StatusCode: 400, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.unknownField": {"message": "Not allowed"}}}}`)},
+			expectedUnmatched: []string{"unknownField: Not allowed"},
+		},
+		{
+			name: "no field",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			addAPIErrorDiagnostics(ctx, &diagnostics, plan, "Error creating project", "Could not create project, unexpected error: ", tt.err)
+
+			var attributePaths []path.Path
+			var general []diag.Diagnostic
+			for _, diagnostic := range diagnostics {
+				if withPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+					attributePaths = append(attributePaths, withPath.Path())
+					continue
+				}
+				general = append(general, diagnostic)
+			}
+			if len(attributePaths) != len(tt.expectedAttributes) {
+				t.Fatalf("attribute diagnostics = %v, want %v", attributePaths, tt.expectedAttributes)
+			}
+			for i := range attributePaths {
+				if !attributePaths[i].Equal(tt.expectedAttributes[i]) {
+					t.Errorf("attribute diagnostic %d = %s, want %s", i, attributePaths[i], tt.expectedAttributes[i])
+				}
+			}
+
+			// The error is always reported on the resource, with the detail and the unmatched fields
+			if len(general) != 1 {
+				t.Fatalf("general diagnostics = %v, want 1", general)
+			}
+			if !strings.HasPrefix(general[0].Detail(), "Could not create project, unexpected error: "+tt.err.Error()) {
+				t.Errorf("general diagnostic detail = %q, want the detail and the error", general[0].Detail())
+			}
+			for _, unmatched := range tt.expectedUnmatched {
+				if !strings.Contains(general[0].Detail(), unmatched) {
+					t.Errorf("general diagnostic detail = %q, want %q", general[0].Detail(), unmatched)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAttributePathOfField(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewProjectResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	tests := []struct {
+		field    string
+		expected path.Path
+		ok       bool
+	}{
+		{field: "name", expected: path.Root("name"), ok: true},
+		{field: "dbtVersion", expected: path.Root("dbt_version"), ok: true},
+		{field: "warehouseConnection.dataset", expected: path.Root("warehouse_connection").AtName("dataset"), ok: true},
+		{field: "warehouseConnection.keyfileContents", expected: path.Root("warehouse_connection").AtName("keyfile_contents"), ok: true},
+		{field: "warehouseConnection.unknownField", ok: false},
+		{field: "unknownField", ok: false},
+		{field: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			attributePath, ok := getAttributePathOfField(ctx, plan, tt.field)
+			if ok != tt.ok {
+				t.Fatalf("getAttributePathOfField(%q) ok = %v, want %v", tt.field, ok, tt.ok)
+			}
+			if ok && !attributePath.Equal(tt.expected) {
+				t.Errorf("getAttributePathOfField(%q) = %s, want %s", tt.field, attributePath, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCamelToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"name":                                 "name",
+		"dbtVersion":                           "dbt_version",
+		"organizationWarehouseCredentialsUuid": "organization_warehouse_credentials_uuid",
+	}
+	for name, expected := range tests {
+		if got := camelToSnakeCase(name); got != expected {
+			t.Errorf("camelToSnakeCase(%q) = %q, want %q", name, got, expected)
+		}
+	}
+}
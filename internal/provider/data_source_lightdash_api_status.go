@@ -0,0 +1,129 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &apiStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiStatusDataSource{}
+)
+
+func NewApiStatusDataSource() datasource.DataSource {
+	return &apiStatusDataSource{}
+}
+
+// apiStatusDataSource defines the data source implementation.
+type apiStatusDataSource struct {
+	client *api.Client
+}
+
+// apiStatusDataSourceModel describes the data source data model.
+type apiStatusDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	RateLimitLimit     types.Int64  `tfsdk:"rate_limit_limit"`
+	RateLimitRemaining types.Int64  `tfsdk:"rate_limit_remaining"`
+	RateLimitReset     types.Int64  `tfsdk:"rate_limit_reset"`
+}
+
+func (d *apiStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_status"
+}
+
+func (d *apiStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_api_status.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash API status data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `api-status`.",
+				Computed:            true,
+			},
+			"rate_limit_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests allowed in the current rate limit window. Null if the server doesn't report it.",
+				Computed:            true,
+			},
+			"rate_limit_remaining": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests remaining in the current rate limit window. Null if the server doesn't report it.",
+				Computed:            true,
+			},
+			"rate_limit_reset": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds until the rate limit window resets. Null if the server doesn't report it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *apiStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *apiStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state apiStatusDataSourceModel
+
+	// Make a lightweight authenticated request, and read the rate limit from its own response headers
+	rateLimit, err := d.client.GetRateLimitStatusV1()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get Lightdash API status",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("api-status")
+	state.RateLimitLimit = types.Int64PointerValue(rateLimit.Limit)
+	state.RateLimitRemaining = types.Int64PointerValue(rateLimit.Remaining)
+	state.RateLimitReset = types.Int64PointerValue(rateLimit.Reset)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
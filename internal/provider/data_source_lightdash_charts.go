@@ -0,0 +1,192 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &chartsDataSource{}
+	_ datasource.DataSourceWithConfigure = &chartsDataSource{}
+)
+
+func NewChartsDataSource() datasource.DataSource {
+	return &chartsDataSource{}
+}
+
+// chartsDataSource defines the data source implementation.
+type chartsDataSource struct {
+	client *api.Client
+}
+
+type chartModel struct {
+	ChartUUID types.String `tfsdk:"chart_uuid"`
+	Name      types.String `tfsdk:"name"`
+	SpaceUUID types.String `tfsdk:"space_uuid"`
+	ChartType types.String `tfsdk:"chart_type"`
+	Slug      types.String `tfsdk:"slug"`
+}
+
+// chartsDataSourceModel describes the data source data model.
+type chartsDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	SpaceUUID   types.String `tfsdk:"space_uuid"`
+	Charts      []chartModel `tfsdk:"charts"`
+}
+
+func (d *chartsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_charts"
+}
+
+func (d *chartsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_charts.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lists Lightdash saved charts within a project or a space",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/charts`, or `projects/<project_uuid>/spaces/<space_uuid>/charts` when `space_uuid` is set.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of a space to only list its charts. The charts of its child spaces are not included.",
+				Optional:            true,
+			},
+			"charts": schema.ListNestedAttribute{
+				MarkdownDescription: "The charts, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chart_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the saved chart.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the chart.",
+							Computed:            true,
+						},
+						"space_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the space containing the chart.",
+							Computed:            true,
+						},
+						"chart_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the chart, e.g. `cartesian`, `table` or `big_number`. It is null when the Lightdash instance doesn't return it.",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The slug of the chart. It is null when the Lightdash instance doesn't return slugs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *chartsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *chartsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state chartsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	charts, err := apiv1.ListChartsInProjectV1(d.client, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash charts for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	// The list endpoint returns the charts of the whole project
+	stateId := fmt.Sprintf("projects/%s/charts", projectUuid)
+	if !state.SpaceUUID.IsNull() {
+		charts = filterChartsBySpace(charts, state.SpaceUUID.ValueString())
+		stateId = fmt.Sprintf("projects/%s/spaces/%s/charts", projectUuid, state.SpaceUUID.ValueString())
+	}
+
+	state.Charts = []chartModel{}
+	for _, chart := range charts {
+		state.Charts = append(state.Charts, chartModel{
+			ChartUUID: types.StringValue(chart.ChartUUID),
+			Name:      types.StringValue(chart.Name),
+			SpaceUUID: types.StringValue(chart.SpaceUUID),
+			ChartType: types.StringPointerValue(chart.ChartType),
+			Slug:      types.StringPointerValue(chart.Slug),
+		})
+	}
+	sortByName(state.Charts,
+		func(chart chartModel) types.String { return chart.Name },
+		func(chart chartModel) types.String { return chart.ChartUUID })
+	state.ID = types.StringValue(stateId)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterChartsBySpace returns the charts directly in the space
+func filterChartsBySpace(charts []apiv1.ListChartsInProjectV1Results, spaceUuid string) []apiv1.ListChartsInProjectV1Results {
+	filtered := []apiv1.ListChartsInProjectV1Results{}
+	for _, chart := range charts {
+		if chart.SpaceUUID == spaceUuid {
+			filtered = append(filtered, chart)
+		}
+	}
+	return filtered
+}
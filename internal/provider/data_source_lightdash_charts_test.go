@@ -0,0 +1,45 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestFilterChartsBySpace(t *testing.T) {
+	charts := []apiv1.ListChartsInProjectV1Results{
+		{ChartUUID: "chart-3", Name: "Revenue", SpaceUUID: "space-1"},
+		{ChartUUID: "chart-2", Name: "Signups", SpaceUUID: "space-2"},
+		{ChartUUID: "chart-1", Name: "Revenue", SpaceUUID: "space-1"},
+		{ChartUUID: "chart-4", Name: "Bookings", SpaceUUID: "space-1"},
+	}
+
+	filtered := filterChartsBySpace(charts, "space-1")
+	expected := []string{"chart-3", "chart-1", "chart-4"}
+	if len(filtered) != len(expected) {
+		t.Fatalf("filterChartsBySpace() returned %d charts, want %d", len(filtered), len(expected))
+	}
+	for i, chart := range filtered {
+		if chart.ChartUUID != expected[i] {
+			t.Errorf("chart %d = %s, want %s", i, chart.ChartUUID, expected[i])
+		}
+	}
+
+	if filtered := filterChartsBySpace(charts, "space-3"); len(filtered) != 0 {
+		t.Errorf("filterChartsBySpace() returned %d charts, want none", len(filtered))
+	}
+}
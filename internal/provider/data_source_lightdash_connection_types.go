@@ -0,0 +1,158 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &connectionTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionTypesDataSource{}
+)
+
+func NewConnectionTypesDataSource() datasource.DataSource {
+	return &connectionTypesDataSource{}
+}
+
+// connectionTypesDataSource defines the data source implementation.
+type connectionTypesDataSource struct {
+	client *api.Client
+}
+
+// connectionTypesDataSourceModel describes the data source data model.
+type connectionTypesDataSourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	Version                 types.String   `tfsdk:"version"`
+	SupportedWarehouseTypes []types.String `tfsdk:"supported_warehouse_types"`
+	SupportedDbtTypes       []types.String `tfsdk:"supported_dbt_types"`
+}
+
+func (d *connectionTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_types"
+}
+
+func (d *connectionTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_connection_types.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash supported connection types data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `connection-types`.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Lightdash server.",
+				Computed:            true,
+			},
+			"supported_warehouse_types": schema.ListAttribute{
+				MarkdownDescription: "The warehouse connection types known to the provider, sorted alphabetically. The server doesn't advertise its warehouse types, so the list doesn't depend on the server.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"supported_dbt_types": schema.ListAttribute{
+				MarkdownDescription: "The dbt connection types supported by the server, sorted alphabetically. The local `dbt` type is only listed when the server has local dbt enabled.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *connectionTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *connectionTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state connectionTypesDataSourceModel
+
+	// Get the capabilities of the server
+	health, err := apiv1.GetHealthV1(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get Lightdash health",
+			err.Error(),
+		)
+		return
+	}
+
+	warehouseTypes, dbtTypes := getSupportedConnectionTypes(health.LocalDbtEnabled)
+
+	state.ID = types.StringValue("connection-types")
+	state.Version = types.StringValue(health.Version)
+	state.SupportedWarehouseTypes = toStringValues(warehouseTypes)
+	state.SupportedDbtTypes = toStringValues(dbtTypes)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getSupportedConnectionTypes returns the sorted warehouse and dbt connection types.
+// The server doesn't advertise its warehouse types, so they are the types known to the provider.
+// The local dbt connection is only available when the server enables it.
+func getSupportedConnectionTypes(localDbtEnabled bool) ([]string, []string) {
+	warehouseTypes := []string{}
+	for _, warehouseType := range models.SupportedWarehouseTypes {
+		warehouseTypes = append(warehouseTypes, string(warehouseType))
+	}
+
+	dbtTypes := []string{}
+	for _, dbtType := range models.SupportedDbtProjectTypes {
+		if dbtType == models.DbtProjectTypeDbt && !localDbtEnabled {
+			continue
+		}
+		dbtTypes = append(dbtTypes, string(dbtType))
+	}
+
+	sort.Strings(warehouseTypes)
+	sort.Strings(dbtTypes)
+	return warehouseTypes, dbtTypes
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestGetSupportedConnectionTypes(t *testing.T) {
+	tests := []struct {
+		name            string
+		localDbtEnabled bool
+		expectLocalDbt  bool
+	}{
+		{
+			name:            "local dbt enabled",
+			localDbtEnabled: true,
+			expectLocalDbt:  true,
+		},
+		{
+			name: "local dbt disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warehouseTypes, dbtTypes := getSupportedConnectionTypes(tt.localDbtEnabled)
+			// The warehouse types don't depend on the server
+			if len(warehouseTypes) != len(models.SupportedWarehouseTypes) || !sort.StringsAreSorted(warehouseTypes) {
+				t.Errorf("getSupportedConnectionTypes() warehouse types = %v, want all the known types sorted", warehouseTypes)
+			}
+			if !slices.Contains(warehouseTypes, string(models.WarehouseTypeBigQuery)) {
+				t.Errorf("getSupportedConnectionTypes() warehouse types = %v, want bigquery", warehouseTypes)
+			}
+			if !sort.StringsAreSorted(dbtTypes) {
+				t.Errorf("getSupportedConnectionTypes() dbt types = %v, want sorted", dbtTypes)
+			}
+			if slices.Contains(dbtTypes, string(models.DbtProjectTypeDbt)) != tt.expectLocalDbt {
+				t.Errorf("getSupportedConnectionTypes() dbt types = %v, expectLocalDbt %v", dbtTypes, tt.expectLocalDbt)
+			}
+			if !slices.Contains(dbtTypes, string(models.DbtProjectTypeGithub)) {
+				t.Errorf("getSupportedConnectionTypes() dbt types = %v, want github", dbtTypes)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &dashboardsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dashboardsDataSource{}
+)
+
+func NewDashboardsDataSource() datasource.DataSource {
+	return &dashboardsDataSource{}
+}
+
+// dashboardsDataSource defines the data source implementation.
+type dashboardsDataSource struct {
+	client *api.Client
+}
+
+type dashboardModel struct {
+	DashboardUUID types.String `tfsdk:"dashboard_uuid"`
+	Name          types.String `tfsdk:"name"`
+	SpaceUUID     types.String `tfsdk:"space_uuid"`
+	Slug          types.String `tfsdk:"slug"`
+}
+
+// dashboardsDataSourceModel describes the data source data model.
+type dashboardsDataSourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	ProjectUUID types.String     `tfsdk:"project_uuid"`
+	SpaceUUID   types.String     `tfsdk:"space_uuid"`
+	Dashboards  []dashboardModel `tfsdk:"dashboards"`
+}
+
+func (d *dashboardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboards"
+}
+
+func (d *dashboardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_dashboards.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lists Lightdash dashboards within a project or a space",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/dashboards`, or `projects/<project_uuid>/spaces/<space_uuid>/dashboards` when `space_uuid` is set.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of a space to only list its dashboards. The dashboards of its child spaces are not included.",
+				Optional:            true,
+			},
+			"dashboards": schema.ListNestedAttribute{
+				MarkdownDescription: "The dashboards, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dashboard_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the dashboard.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the dashboard.",
+							Computed:            true,
+						},
+						"space_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the space containing the dashboard.",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The slug of the dashboard. It is null when the Lightdash instance doesn't return slugs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dashboardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dashboardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dashboardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	dashboards, err := apiv1.ListDashboardsInProjectV1(d.client, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash dashboards for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	// The list endpoint returns the dashboards of the whole project
+	stateId := fmt.Sprintf("projects/%s/dashboards", projectUuid)
+	if !state.SpaceUUID.IsNull() {
+		dashboards = filterDashboardsBySpace(dashboards, state.SpaceUUID.ValueString())
+		stateId = fmt.Sprintf("projects/%s/spaces/%s/dashboards", projectUuid, state.SpaceUUID.ValueString())
+	}
+
+	state.Dashboards = []dashboardModel{}
+	for _, dashboard := range dashboards {
+		state.Dashboards = append(state.Dashboards, dashboardModel{
+			DashboardUUID: types.StringValue(dashboard.DashboardUUID),
+			Name:          types.StringValue(dashboard.Name),
+			SpaceUUID:     types.StringValue(dashboard.SpaceUUID),
+			Slug:          types.StringPointerValue(dashboard.Slug),
+		})
+	}
+	sortByName(state.Dashboards,
+		func(dashboard dashboardModel) types.String { return dashboard.Name },
+		func(dashboard dashboardModel) types.String { return dashboard.DashboardUUID })
+	state.ID = types.StringValue(stateId)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterDashboardsBySpace returns the dashboards directly in the space
+func filterDashboardsBySpace(dashboards []apiv1.ListDashboardsInProjectV1Results, spaceUuid string) []apiv1.ListDashboardsInProjectV1Results {
+	filtered := []apiv1.ListDashboardsInProjectV1Results{}
+	for _, dashboard := range dashboards {
+		if dashboard.SpaceUUID == spaceUuid {
+			filtered = append(filtered, dashboard)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestFilterDashboardsBySpace(t *testing.T) {
+	dashboards := []apiv1.ListDashboardsInProjectV1Results{
+		{DashboardUUID: "dashboard-3", Name: "Revenue", SpaceUUID: "space-1"},
+		{DashboardUUID: "dashboard-2", Name: "Acquisition", SpaceUUID: "space-2"},
+		{DashboardUUID: "dashboard-1", Name: "Revenue", SpaceUUID: "space-1"},
+		{DashboardUUID: "dashboard-4", Name: "Churn", SpaceUUID: "space-1"},
+	}
+
+	filtered := filterDashboardsBySpace(dashboards, "space-1")
+	expected := []string{"dashboard-3", "dashboard-1", "dashboard-4"}
+	if len(filtered) != len(expected) {
+		t.Fatalf("filterDashboardsBySpace() returned %d dashboards, want %d", len(filtered), len(expected))
+	}
+	for i, dashboard := range filtered {
+		if dashboard.DashboardUUID != expected[i] {
+			t.Errorf("dashboard %d = %s, want %s", i, dashboard.DashboardUUID, expected[i])
+		}
+	}
+
+	if filtered := filterDashboardsBySpace(dashboards, "space-3"); len(filtered) != 0 {
+		t.Errorf("filterDashboardsBySpace() returned %d dashboards, want none", len(filtered))
+	}
+}
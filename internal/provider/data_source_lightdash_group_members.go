@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -46,8 +45,9 @@ type groupMemberModelForGroupMembers struct {
 	// NOTE Those aren't exposed, as they are sensitive data.
 	// LastName  types.String `tfsdk:"last_name"`
 	// FirstName types.String `tfsdk:"first_name"`
-	// Email     types.String `tfsdk:"email"`
 	UserUUID types.String `tfsdk:"user_uuid"`
+	// The email is needed to reconcile the membership, so it is exposed as a sensitive value.
+	Email types.String `tfsdk:"email"`
 }
 
 // groupMembersDataSourceModel describes the data source data model.
@@ -102,6 +102,10 @@ func (d *groupMembersDataSource) Schema(ctx context.Context, req datasource.Sche
 							MarkdownDescription: "The UUID of the Lightdash user who is a member of the group.",
 							Computed:            true,
 						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the Lightdash user who is a member of the group.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -147,13 +151,14 @@ func (d *groupMembersDataSource) Read(ctx context.Context, req datasource.ReadRe
 	for _, member := range members {
 		member := groupMemberModelForGroupMembers{
 			UserUUID: types.StringValue(member.UserUUID),
+			Email:    types.StringValue(member.Email),
 		}
 		updatedMembers = append(updatedMembers, member)
 	}
-	// Sort the members by user UUID
-	sort.Slice(updatedMembers, func(i, j int) bool {
-		return updatedMembers[i].UserUUID.ValueString() < updatedMembers[j].UserUUID.ValueString()
-	})
+	// Sort the members by email, then by user UUID
+	sortByName(updatedMembers,
+		func(m groupMemberModelForGroupMembers) types.String { return m.Email },
+		func(m groupMemberModelForGroupMembers) types.String { return m.UserUUID })
 	state.Members = updatedMembers
 
 	// Set resource ID
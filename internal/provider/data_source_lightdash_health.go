@@ -0,0 +1,214 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &healthDataSource{}
+	_ datasource.DataSourceWithConfigure = &healthDataSource{}
+)
+
+func NewHealthDataSource() datasource.DataSource {
+	return &healthDataSource{}
+}
+
+// healthDataSource defines the data source implementation.
+type healthDataSource struct {
+	client *api.Client
+}
+
+// healthDataSourceModel describes the data source data model.
+type healthDataSourceModel struct {
+	ID                      types.String         `tfsdk:"id"`
+	Healthy                 types.Bool           `tfsdk:"healthy"`
+	Version                 types.String         `tfsdk:"version"`
+	Mode                    types.String         `tfsdk:"mode"`
+	SiteURL                 types.String         `tfsdk:"site_url"`
+	RequiresOrgRegistration types.Bool           `tfsdk:"requires_org_registration"`
+	IsAuthenticated         types.Bool           `tfsdk:"is_authenticated"`
+	LocalDbtEnabled         types.Bool           `tfsdk:"local_dbt_enabled"`
+	HasGithub               types.Bool           `tfsdk:"has_github"`
+	HasGitlab               types.Bool           `tfsdk:"has_gitlab"`
+	Auth                    *healthAuthDataModel `tfsdk:"auth"`
+}
+
+// healthAuthDataModel describes the authentication capabilities of the instance.
+type healthAuthDataModel struct {
+	PasswordEnabled types.Bool `tfsdk:"password_enabled"`
+	GoogleEnabled   types.Bool `tfsdk:"google_enabled"`
+	OktaEnabled     types.Bool `tfsdk:"okta_enabled"`
+	OneLoginEnabled types.Bool `tfsdk:"one_login_enabled"`
+	AzureADEnabled  types.Bool `tfsdk:"azure_ad_enabled"`
+	OIDCEnabled     types.Bool `tfsdk:"oidc_enabled"`
+}
+
+func (d *healthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *healthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_health.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash health data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `health`.",
+				Computed:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Lightdash instance reports itself as healthy.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of Lightdash, e.g. `0.1500.0`.",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The mode of the Lightdash instance, e.g. `default`.",
+				Computed:            true,
+			},
+			"site_url": schema.StringAttribute{
+				MarkdownDescription: "The public URL of the Lightdash instance.",
+				Computed:            true,
+			},
+			"requires_org_registration": schema.BoolAttribute{
+				MarkdownDescription: "Whether the instance still requires an organization to be registered.",
+				Computed:            true,
+			},
+			"is_authenticated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the credentials of the provider are accepted by the instance.",
+				Computed:            true,
+			},
+			"local_dbt_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether projects can use a local dbt project.",
+				Computed:            true,
+			},
+			"has_github": schema.BoolAttribute{
+				MarkdownDescription: "Whether the GitHub App is installed on the instance.",
+				Computed:            true,
+			},
+			"has_gitlab": schema.BoolAttribute{
+				MarkdownDescription: "Whether the GitLab integration is configured on the instance.",
+				Computed:            true,
+			},
+			"auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "The authentication methods enabled on the instance.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"password_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with a password.",
+						Computed:            true,
+					},
+					"google_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with Google.",
+						Computed:            true,
+					},
+					"okta_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with Okta.",
+						Computed:            true,
+					},
+					"one_login_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with OneLogin.",
+						Computed:            true,
+					},
+					"azure_ad_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with Azure AD.",
+						Computed:            true,
+					},
+					"oidc_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether users can log in with a generic OpenID Connect provider.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *healthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state healthDataSourceModel
+
+	health, err := apiv1.GetHealthV1(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get Lightdash health",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("health")
+	state.Healthy = types.BoolValue(health.Healthy)
+	state.Version = types.StringValue(health.Version)
+	state.Mode = types.StringValue(health.Mode)
+	state.SiteURL = types.StringValue(health.SiteUrl)
+	state.RequiresOrgRegistration = types.BoolValue(health.RequiresOrgRegistration)
+	state.IsAuthenticated = types.BoolValue(health.IsAuthenticated)
+	state.LocalDbtEnabled = types.BoolValue(health.LocalDbtEnabled)
+	state.HasGithub = types.BoolValue(health.HasGithub)
+	state.HasGitlab = types.BoolValue(health.HasGitlab)
+	state.Auth = &healthAuthDataModel{
+		PasswordEnabled: types.BoolValue(!health.Auth.DisablePasswordAuthentication),
+		GoogleEnabled:   types.BoolValue(health.Auth.Google.Enabled),
+		OktaEnabled:     types.BoolValue(health.Auth.Okta.Enabled),
+		OneLoginEnabled: types.BoolValue(health.Auth.OneLogin.Enabled),
+		AzureADEnabled:  types.BoolValue(health.Auth.AzureAD.Enabled),
+		OIDCEnabled:     types.BoolValue(health.Auth.OIDC.Enabled),
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
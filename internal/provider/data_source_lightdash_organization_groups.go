@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -154,9 +153,7 @@ func (d *organizationGroupsDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Sort the groups by group UUID
-	sort.Slice(fetchedGroups, func(i, j int) bool {
-		return fetchedGroups[i].GroupUuid.ValueString() < fetchedGroups[j].GroupUuid.ValueString()
-	})
+	sortByUUID(fetchedGroups, func(item organizationGroupModel) types.String { return item.GroupUuid })
 
 	// Set resource ID
 	state.ID = types.StringValue(fmt.Sprintf("organizations/%s/groups", state.OrganizationUuid.ValueString()))
@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -166,9 +165,7 @@ func (d *organizationMembersDataSource) Read(ctx context.Context, req datasource
 	}
 
 	// Sort the members by user UUID
-	sort.Slice(newMembers, func(i, j int) bool {
-		return newMembers[i].UserUuid.ValueString() < newMembers[j].UserUuid.ValueString()
-	})
+	sortByUUID(newMembers, func(item organizationMemberModel) types.String { return item.UserUuid })
 
 	// log the number of new members
 	tflog.Info(ctx, fmt.Sprintf("Updated organization members: %d", len(newMembers)))
@@ -0,0 +1,167 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &organizationSeatUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationSeatUsageDataSource{}
+)
+
+func NewOrganizationSeatUsageDataSource() datasource.DataSource {
+	return &organizationSeatUsageDataSource{}
+}
+
+// organizationSeatUsageDataSource defines the data source implementation.
+type organizationSeatUsageDataSource struct {
+	client *api.Client
+}
+
+// organizationSeatUsageDataSourceModel describes the data source data model.
+type organizationSeatUsageDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+	MemberCount      types.Int64  `tfsdk:"member_count"`
+	ActiveMembers    types.Int64  `tfsdk:"active_members"`
+	SeatLimit        types.Int64  `tfsdk:"seat_limit"`
+	SeatsRemaining   types.Int64  `tfsdk:"seats_remaining"`
+}
+
+func (d *organizationSeatUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_seat_usage"
+}
+
+func (d *organizationSeatUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_organization_seat_usage.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash organization seat usage data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `organizations/<organization_uuid>/seat_usage`.",
+				Computed:            true,
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash organization.",
+				Computed:            true,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members of the organization, including the deactivated users.",
+				Computed:            true,
+			},
+			"active_members": schema.Int64Attribute{
+				MarkdownDescription: "The number of active members of the organization, which occupy a seat.",
+				Computed:            true,
+			},
+			"seat_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats of the plan of the organization. The Lightdash API doesn't expose it, so it is set from the configuration, e.g. from the contract.",
+				Optional:            true,
+			},
+			"seats_remaining": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats left, i.e. `seat_limit` minus `active_members`. It is negative when the plan is exceeded, and null when `seat_limit` is not set.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *organizationSeatUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *organizationSeatUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationSeatUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get information of the organization
+	organization, err := apiv1.GetMyOrganizationV1(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get organization",
+			err.Error(),
+		)
+		return
+	}
+
+	// Get all members in the organization
+	members, err := services.GetOrganizationMembersService(d.client).GetOrganizationMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get organization members",
+			err.Error(),
+		)
+		return
+	}
+
+	memberCount, activeMembers := countOrganizationSeats(members)
+	state.ID = types.StringValue(fmt.Sprintf("organizations/%s/seat_usage", organization.OrganizationUUID))
+	state.OrganizationUUID = types.StringValue(organization.OrganizationUUID)
+	state.MemberCount = types.Int64Value(memberCount)
+	state.ActiveMembers = types.Int64Value(activeMembers)
+	state.SeatsRemaining = types.Int64Null()
+	if !state.SeatLimit.IsNull() {
+		state.SeatsRemaining = types.Int64Value(state.SeatLimit.ValueInt64() - activeMembers)
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// countOrganizationSeats returns the number of members of the organization and the number of active members
+func countOrganizationSeats(members []apiv1.GetOrganizationMembersV1Results) (int64, int64) {
+	var activeMembers int64
+	for _, member := range members {
+		if member.IsActive {
+			activeMembers++
+		}
+	}
+	return int64(len(members)), activeMembers
+}
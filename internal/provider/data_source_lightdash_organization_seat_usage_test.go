@@ -0,0 +1,41 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestCountOrganizationSeats(t *testing.T) {
+	members := []apiv1.GetOrganizationMembersV1Results{
+		{UserUUID: "user-1", IsActive: true},
+		{UserUUID: "user-2", IsActive: false},
+		{UserUUID: "user-3", IsActive: true},
+	}
+
+	memberCount, activeMembers := countOrganizationSeats(members)
+	if memberCount != 3 {
+		t.Errorf("memberCount = %d, want 3", memberCount)
+	}
+	if activeMembers != 2 {
+		t.Errorf("activeMembers = %d, want 2", activeMembers)
+	}
+
+	if memberCount, activeMembers := countOrganizationSeats(nil); memberCount != 0 || activeMembers != 0 {
+		t.Errorf("countOrganizationSeats(nil) = %d, %d, want 0, 0", memberCount, activeMembers)
+	}
+}
@@ -17,7 +17,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -42,12 +42,13 @@ type personalAccessTokensDataSource struct {
 
 // personalAccessTokenModel describes the data source data model for a personal access token.
 type personalAccessTokenModel struct {
-	TokenUUID   types.String `tfsdk:"token_uuid"`
-	Description types.String `tfsdk:"description"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	ExpiresAt   types.String `tfsdk:"expires_at"`
-	RotatedAt   types.String `tfsdk:"rotated_at"`
-	LastUsedAt  types.String `tfsdk:"last_used_at"`
+	TokenUUID         types.String `tfsdk:"token_uuid"`
+	Description       types.String `tfsdk:"description"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	RotatedAt         types.String `tfsdk:"rotated_at"`
+	LastUsedAt        types.String `tfsdk:"last_used_at"`
+	DaysSinceLastUsed types.Int64  `tfsdk:"days_since_last_used"`
 }
 
 // personalAccessTokensDataSourceModel describes the data source data model.
@@ -107,6 +108,10 @@ func (d *personalAccessTokensDataSource) Schema(ctx context.Context, req datasou
 							MarkdownDescription: "The timestamp when the personal access token was last used.",
 							Computed:            true,
 						},
+						"days_since_last_used": schema.Int64Attribute{
+							MarkdownDescription: "The number of whole days since the personal access token was last used. It is null when the token has never been used.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -142,7 +147,7 @@ func (d *personalAccessTokensDataSource) Read(ctx context.Context, req datasourc
 	}
 
 	// Get all personal access tokens
-	tokens, err := d.client.ListPersonalAccessTokensV1()
+	tokens, err := d.client.ListPersonalAccessTokensV1(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get personal access tokens",
@@ -152,6 +157,7 @@ func (d *personalAccessTokensDataSource) Read(ctx context.Context, req datasourc
 	}
 
 	// Convert to model
+	now := time.Now()
 	fetchedTokens := []personalAccessTokenModel{}
 	for _, token := range tokens {
 		fetchedToken := personalAccessTokenModel{
@@ -175,17 +181,25 @@ func (d *personalAccessTokensDataSource) Read(ctx context.Context, req datasourc
 
 		if token.LastUsedAt != nil {
 			fetchedToken.LastUsedAt = types.StringValue(*token.LastUsedAt)
+			daysSinceLastUsed, err := getDaysSince(*token.LastUsedAt, now)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to parse the last used timestamp",
+					fmt.Sprintf("Could not parse the last used timestamp of personal access token %s: %s", token.UUID, err.Error()),
+				)
+				return
+			}
+			fetchedToken.DaysSinceLastUsed = types.Int64Value(daysSinceLastUsed)
 		} else {
 			fetchedToken.LastUsedAt = types.StringNull()
+			fetchedToken.DaysSinceLastUsed = types.Int64Null()
 		}
 
 		fetchedTokens = append(fetchedTokens, fetchedToken)
 	}
 
 	// Sort the tokens by token UUID
-	sort.Slice(fetchedTokens, func(i, j int) bool {
-		return fetchedTokens[i].TokenUUID.ValueString() < fetchedTokens[j].TokenUUID.ValueString()
-	})
+	sortByUUID(fetchedTokens, func(item personalAccessTokenModel) types.String { return item.TokenUUID })
 
 	// Set resource ID
 	state.ID = types.StringValue("personal-access-tokens")
@@ -198,3 +212,15 @@ func (d *personalAccessTokensDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 }
+
+// getDaysSince returns the number of whole days elapsed between the RFC 3339 timestamp and now
+func getDaysSince(timestamp string, now time.Time) (int64, error) {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, err
+	}
+	if t.After(now) {
+		return 0, nil
+	}
+	return int64(now.Sub(t) / (24 * time.Hour)), nil
+}
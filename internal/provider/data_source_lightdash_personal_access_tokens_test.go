@@ -0,0 +1,48 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetDaysSince(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		timestamp   string
+		expected    int64
+		expectError bool
+	}{
+		{timestamp: "2024-06-01T11:00:00Z", expected: 0},
+		{timestamp: "2024-05-31T12:00:00Z", expected: 1},
+		{timestamp: "2024-03-03T13:00:00.123Z", expected: 89},
+		{timestamp: "2024-03-03T12:00:00+00:00", expected: 90},
+		{timestamp: "2024-06-02T00:00:00Z", expected: 0},
+		{timestamp: "not a timestamp", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timestamp, func(t *testing.T) {
+			days, err := getDaysSince(tt.timestamp, now)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("getDaysSince(%q) error = %v, expectError %v", tt.timestamp, err, tt.expectError)
+			}
+			if days != tt.expected {
+				t.Errorf("getDaysSince(%q) = %d, want %d", tt.timestamp, days, tt.expected)
+			}
+		})
+	}
+}
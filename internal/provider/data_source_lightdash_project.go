@@ -118,7 +118,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	project_uuid := state.ProjectUuid.ValueString()
-	project, err := apiv1.GetProjectV1(d.client, project_uuid)
+	project, err := apiv1.GetProjectV1(ctx, d.client, project_uuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Lightdash project",
@@ -0,0 +1,189 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectDataCatalogDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectDataCatalogDataSource{}
+)
+
+func NewProjectDataCatalogDataSource() datasource.DataSource {
+	return &projectDataCatalogDataSource{}
+}
+
+// projectDataCatalogDataSource defines the data source implementation.
+type projectDataCatalogDataSource struct {
+	client *api.Client
+}
+
+type nestedDataCatalogFieldModel struct {
+	Table       types.String `tfsdk:"table"`
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	Type        types.String `tfsdk:"type"`
+	FieldType   types.String `tfsdk:"field_type"`
+	Description types.String `tfsdk:"description"`
+}
+
+// projectDataCatalogDataSourceModel describes the data source data model.
+type projectDataCatalogDataSourceModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	ProjectUUID types.String                  `tfsdk:"project_uuid"`
+	Search      types.String                  `tfsdk:"search"`
+	Fields      []nestedDataCatalogFieldModel `tfsdk:"fields"`
+}
+
+func (d *projectDataCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_data_catalog"
+}
+
+func (d *projectDataCatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_project_data_catalog.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash project data catalog data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/data-catalog`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return the fields matching this search text, as in the Lightdash catalog search.",
+				Optional:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The dimensions and metrics of the catalog, sorted by table and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"table": schema.StringAttribute{
+							MarkdownDescription: "The name of the table of the field.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the field.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the field.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The basic type of the values of the field, e.g. 'string', 'number', 'date' or 'boolean'.",
+							Computed:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: "The type of field: 'dimension' or 'metric'.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the field.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectDataCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *projectDataCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectDataCatalogDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	fields, err := apiv1.ListDataCatalogFieldsV1(d.client, projectUuid, state.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash data catalog for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	fetchedFields := []nestedDataCatalogFieldModel{}
+	for _, field := range fields {
+		fetchedFields = append(fetchedFields, nestedDataCatalogFieldModel{
+			Table:       types.StringValue(field.TableName),
+			Name:        types.StringValue(field.Name),
+			Label:       types.StringValue(field.Label),
+			Type:        types.StringValue(field.BasicType),
+			FieldType:   types.StringValue(field.FieldType),
+			Description: types.StringPointerValue(field.Description),
+		})
+	}
+	// Sort the fields by table and name, which are unique together in a project
+	sort.SliceStable(fetchedFields, func(i, j int) bool {
+		if fetchedFields[i].Table.ValueString() != fetchedFields[j].Table.ValueString() {
+			return fetchedFields[i].Table.ValueString() < fetchedFields[j].Table.ValueString()
+		}
+		return fetchedFields[i].Name.ValueString() < fetchedFields[j].Name.ValueString()
+	})
+	state.Fields = fetchedFields
+
+	// Set resource ID
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/data-catalog", projectUuid))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectEffectiveRoleDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectEffectiveRoleDataSource{}
+)
+
+// The sources of the effective role of a user on a project
+const (
+	projectRoleSourceDirect       = "direct"
+	projectRoleSourceGroup        = "group"
+	projectRoleSourceOrganization = "organization"
+)
+
+func NewProjectEffectiveRoleDataSource() datasource.DataSource {
+	return &projectEffectiveRoleDataSource{}
+}
+
+// projectEffectiveRoleDataSource defines the data source implementation.
+type projectEffectiveRoleDataSource struct {
+	client *api.Client
+}
+
+// projectEffectiveRoleDataSourceModel describes the data source data model.
+type projectEffectiveRoleDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	UserUUID    types.String `tfsdk:"user_uuid"`
+	Role        types.String `tfsdk:"role"`
+	Source      types.String `tfsdk:"source"`
+	GroupUUID   types.String `tfsdk:"group_uuid"`
+}
+
+// projectRoleGrant is a role granted to a user on a project, directly, through a group or by the organization role
+type projectRoleGrant struct {
+	Role      models.ProjectMemberRole
+	Source    string
+	GroupUUID string
+}
+
+func (d *projectEffectiveRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_effective_role"
+}
+
+func (d *projectEffectiveRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_project_effective_role.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Resolves the effective role of a Lightdash user on a project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/users/<user_uuid>/effective_role`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"user_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash user.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The highest role of the user on the project, e.g. `editor`. It is null when the user has no access to the project.",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "How the role is granted: `direct` for a project member, `group` through a group with access to the project, or `organization` by the organization role of the user. A direct grant takes precedence over a group, and a group over the organization, when they grant the same role. It is null when the user has no access to the project.",
+				Computed:            true,
+			},
+			"group_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the group granting the role when `source` is `group`, and null otherwise.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *projectEffectiveRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *projectEffectiveRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectEffectiveRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	userUuid := state.UserUUID.ValueString()
+	grants, err := d.getProjectRoleGrants(projectUuid, userUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read the effective role of user %s on project %s", userUuid, projectUuid),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Role = types.StringNull()
+	state.Source = types.StringNull()
+	state.GroupUUID = types.StringNull()
+	if grant := getEffectiveProjectRoleGrant(grants); grant != nil {
+		state.Role = types.StringValue(grant.Role.String())
+		state.Source = types.StringValue(grant.Source)
+		if grant.Source == projectRoleSourceGroup {
+			state.GroupUUID = types.StringValue(grant.GroupUUID)
+		}
+	}
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/users/%s/effective_role", projectUuid, userUuid))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// getProjectRoleGrants returns the roles granted to the user on the project, from the project members,
+// the organization role of the user and the groups with access to the project.
+// Lightdash has no endpoint returning the access a user inherits on a project, so the grants are resolved here.
+// Listing the members of a group takes a request per group, so it is skipped for the groups that can't grant the effective role.
+func (d *projectEffectiveRoleDataSource) getProjectRoleGrants(projectUuid string, userUuid string) ([]projectRoleGrant, error) {
+	grants := []projectRoleGrant{}
+
+	members, err := apiv1.GetProjectAccessListV1(d.client, projectUuid)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.UserUUID == userUuid {
+			grants = append(grants, projectRoleGrant{Role: member.ProjectRole, Source: projectRoleSourceDirect})
+		}
+	}
+
+	organizationMember, err := apiv1.GetOrganizationMemberByUuidV1(d.client, userUuid)
+	if err != nil {
+		return nil, err
+	}
+	if role, ok := organizationMember.OrganizationRole.ProjectRole(); ok {
+		grants = append(grants, projectRoleGrant{Role: role, Source: projectRoleSourceOrganization})
+	}
+
+	groupAccesses, err := apiv1.GetProjectGroupAccessesV1(d.client, projectUuid)
+	if err != nil {
+		return nil, err
+	}
+	for _, groupAccess := range groupAccesses {
+		grant := projectRoleGrant{Role: groupAccess.ProjectRole, Source: projectRoleSourceGroup, GroupUUID: groupAccess.GroupUUID}
+		if effective := getEffectiveProjectRoleGrant(grants); effective != nil && !grant.outranks(effective) {
+			continue
+		}
+		groupMembers, err := apiv1.GetGroupMembersV1(d.client, groupAccess.GroupUUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, groupMember := range groupMembers {
+			if groupMember.UserUUID == userUuid {
+				grants = append(grants, grant)
+				break
+			}
+		}
+	}
+	return grants, nil
+}
+
+// projectRoleSourcePrecedence orders the sources of the grants of the same role, from the most specific one
+var projectRoleSourcePrecedence = map[string]int{
+	projectRoleSourceDirect:       3,
+	projectRoleSourceGroup:        2,
+	projectRoleSourceOrganization: 1,
+}
+
+// outranks reports whether the grant takes precedence over another one.
+// The grants of the same role are told apart by their source, then by the group UUID to be deterministic.
+func (g *projectRoleGrant) outranks(other *projectRoleGrant) bool {
+	switch {
+	case g.Role.Rank() != other.Role.Rank():
+		return g.Role.Rank() > other.Role.Rank()
+	case g.Source != other.Source:
+		return projectRoleSourcePrecedence[g.Source] > projectRoleSourcePrecedence[other.Source]
+	default:
+		return g.GroupUUID < other.GroupUUID
+	}
+}
+
+// getEffectiveProjectRoleGrant returns the grant of the highest role, or nil when there is no grant
+func getEffectiveProjectRoleGrant(grants []projectRoleGrant) *projectRoleGrant {
+	var effective *projectRoleGrant
+	for i := range grants {
+		if effective == nil || grants[i].outranks(effective) {
+			effective = &grants[i]
+		}
+	}
+	return effective
+}
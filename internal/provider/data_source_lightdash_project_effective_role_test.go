@@ -0,0 +1,118 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestGetEffectiveProjectRoleGrant(t *testing.T) {
+	tests := []struct {
+		name     string
+		grants   []projectRoleGrant
+		expected *projectRoleGrant
+	}{
+		{
+			name: "no access",
+		},
+		{
+			name: "highest role through a group",
+			grants: []projectRoleGrant{
+				{Role: models.PROJECT_VIEWER_ROLE, Source: projectRoleSourceDirect},
+				{Role: models.PROJECT_EDITOR_ROLE, Source: projectRoleSourceGroup, GroupUUID: "group-b"},
+				{Role: models.PROJECT_EDITOR_ROLE, Source: projectRoleSourceGroup, GroupUUID: "group-a"},
+				{Role: models.PROJECT_INTERACTIVE_VIEWER_ROLE, Source: projectRoleSourceOrganization},
+			},
+			expected: &projectRoleGrant{Role: models.PROJECT_EDITOR_ROLE, Source: projectRoleSourceGroup, GroupUUID: "group-a"},
+		},
+		{
+			name: "direct grant of the same role",
+			grants: []projectRoleGrant{
+				{Role: models.PROJECT_ADMIN_ROLE, Source: projectRoleSourceOrganization},
+				{Role: models.PROJECT_ADMIN_ROLE, Source: projectRoleSourceDirect},
+			},
+			expected: &projectRoleGrant{Role: models.PROJECT_ADMIN_ROLE, Source: projectRoleSourceDirect},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getEffectiveProjectRoleGrant(tt.grants)
+			if (got == nil) != (tt.expected == nil) || (got != nil && *got != *tt.expected) {
+				t.Errorf("getEffectiveProjectRoleGrant() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetProjectRoleGrants(t *testing.T) {
+	listedGroups := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/projects/project-uuid/access":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": [
+				{"projectUuid": "project-uuid", "userUuid": "user-uuid", "email": "user@example.com", "role": "viewer"},
+				{"projectUuid": "project-uuid", "userUuid": "other-uuid", "email": "other@example.com", "role": "admin"}
+			]}`))
+		case "/api/v1/org/users/user-uuid":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": {"organizationUuid": "org-uuid", "userUuid": "user-uuid", "email": "user@example.com", "role": "member"}}`))
+		case "/api/v1/projects/project-uuid/groupAccesses":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": [
+				{"projectUuid": "project-uuid", "groupUuid": "group-editor", "role": "editor"},
+				{"projectUuid": "project-uuid", "groupUuid": "group-viewer", "role": "viewer"},
+				{"projectUuid": "project-uuid", "groupUuid": "group-admin", "role": "admin"}
+			]}`))
+		case "/api/v1/groups/group-editor/members", "/api/v1/groups/group-viewer/members":
+			listedGroups = append(listedGroups, strings.Split(r.URL.Path, "/")[4])
+			_, _ = w.Write([]byte(`{"status": "ok", "results": [{"userUuid": "user-uuid", "email": "user@example.com"}]}`))
+		case "/api/v1/groups/group-admin/members":
+			listedGroups = append(listedGroups, "group-admin")
+			_, _ = w.Write([]byte(`{"status": "ok", "results": [{"userUuid": "other-uuid", "email": "other@example.com"}]}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{HTTPClient: server.Client(), HostUrl: server.URL}
+	d := &projectEffectiveRoleDataSource{client: client}
+	grants, err := d.getProjectRoleGrants("project-uuid", "user-uuid")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []projectRoleGrant{
+		{Role: models.PROJECT_VIEWER_ROLE, Source: projectRoleSourceDirect},
+		{Role: models.PROJECT_EDITOR_ROLE, Source: projectRoleSourceGroup, GroupUUID: "group-editor"},
+	}
+	if !reflect.DeepEqual(grants, expected) {
+		t.Errorf("getProjectRoleGrants() = %v, want %v", grants, expected)
+	}
+	// The viewer group can't outrank the direct viewer role, so its members are not listed
+	if !reflect.DeepEqual(listedGroups, []string{"group-editor", "group-admin"}) {
+		t.Errorf("Expected the members of group-editor and group-admin to be listed, got: %v", listedGroups)
+	}
+	if effective := getEffectiveProjectRoleGrant(grants); effective == nil || *effective != expected[1] {
+		t.Errorf("getEffectiveProjectRoleGrant() = %v, want %v", effective, expected[1])
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectExploresDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectExploresDataSource{}
+)
+
+func NewProjectExploresDataSource() datasource.DataSource {
+	return &projectExploresDataSource{}
+}
+
+// projectExploresDataSource defines the data source implementation.
+type projectExploresDataSource struct {
+	client *api.Client
+}
+
+type nestedExploreModel struct {
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	Database    types.String `tfsdk:"database"`
+	Schema      types.String `tfsdk:"schema"`
+	Description types.String `tfsdk:"description"`
+}
+
+// projectExploresDataSourceModel describes the data source data model.
+type projectExploresDataSourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	ProjectUUID types.String         `tfsdk:"project_uuid"`
+	Explores    []nestedExploreModel `tfsdk:"explores"`
+}
+
+func (d *projectExploresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_explores"
+}
+
+func (d *projectExploresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_project_explores.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash project explores data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/explores`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"explores": schema.ListNestedAttribute{
+				MarkdownDescription: "The compiled explores of the project, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the explore, i.e. the name of the dbt model.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the explore.",
+							Computed:            true,
+						},
+						"database": schema.StringAttribute{
+							MarkdownDescription: "The database (e.g. the BigQuery project) of the table of the explore.",
+							Computed:            true,
+						},
+						"schema": schema.StringAttribute{
+							MarkdownDescription: "The schema (e.g. the BigQuery dataset) of the table of the explore.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the explore.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectExploresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *projectExploresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectExploresDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	explores, err := d.client.ListExploresV1(ctx, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash explores for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	fetchedExplores := []nestedExploreModel{}
+	for _, explore := range explores {
+		fetchedExplores = append(fetchedExplores, nestedExploreModel{
+			Name:        types.StringValue(explore.Name),
+			Label:       types.StringValue(explore.Label),
+			Database:    types.StringPointerValue(explore.DatabaseName),
+			Schema:      types.StringPointerValue(explore.SchemaName),
+			Description: types.StringPointerValue(explore.Description),
+		})
+	}
+	// Sort the explores by name, which is unique in a project
+	sortByName(fetchedExplores,
+		func(e nestedExploreModel) types.String { return e.Name },
+		func(e nestedExploreModel) types.String { return e.Label })
+	state.Explores = fetchedExplores
+
+	// Set resource ID
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/explores", projectUuid))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
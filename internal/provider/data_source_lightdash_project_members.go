@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -152,9 +151,7 @@ func (d *projectMembersDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	state.ProjectUUID = types.StringValue(project_uuid)
 	// Sort the members by user UUID
-	sort.Slice(updatedMembers, func(i, j int) bool {
-		return updatedMembers[i].UserUUID.ValueString() < updatedMembers[j].UserUUID.ValueString()
-	})
+	sortByUUID(updatedMembers, func(item projectMemberModel) types.String { return item.UserUUID })
 	state.Members = updatedMembers
 
 	// Set resource ID
@@ -46,6 +46,7 @@ type projectSchedulerSettingsDataSourceModel struct {
 	OrganizationUUID  types.String `tfsdk:"organization_uuid"`
 	ProjectUUID       types.String `tfsdk:"project_uuid"`
 	SchedulerTimezone types.String `tfsdk:"scheduler_timezone"`
+	SchedulerEnabled  types.Bool   `tfsdk:"scheduler_enabled"`
 }
 
 // Metadata defines the metadata for the data source.
@@ -85,6 +86,10 @@ func (d *projectSchedulerSettingsDataSource) Schema(ctx context.Context, req dat
 				Required:            false,
 				Computed:            true,
 			},
+			"scheduler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the scheduled deliveries of the project are enabled.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -129,6 +134,7 @@ func (d *projectSchedulerSettingsDataSource) Read(ctx context.Context, req datas
 
 	// Set the state with fetched settings
 	state.SchedulerTimezone = types.StringValue(settings.GetSchedulerTimezone()) // Change to use the correct field
+	state.SchedulerEnabled = types.BoolValue(settings.IsSchedulerEnabled())
 
 	// Set resource ID
 	state.ID = types.StringValue(fmt.Sprintf("organizations/%s/projects/%s/scheduler_settings",
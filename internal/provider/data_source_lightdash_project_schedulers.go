@@ -0,0 +1,198 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectSchedulersDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectSchedulersDataSource{}
+)
+
+func NewProjectSchedulersDataSource() datasource.DataSource {
+	return &projectSchedulersDataSource{}
+}
+
+// projectSchedulersDataSource defines the data source implementation.
+type projectSchedulersDataSource struct {
+	client *api.Client
+}
+
+type projectSchedulerModel struct {
+	SchedulerUUID types.String `tfsdk:"scheduler_uuid"`
+	Name          types.String `tfsdk:"name"`
+	Cron          types.String `tfsdk:"cron"`
+	Format        types.String `tfsdk:"format"`
+	TargetType    types.String `tfsdk:"target_type"`
+}
+
+// projectSchedulersDataSourceModel describes the data source data model.
+type projectSchedulersDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	ProjectUUID types.String            `tfsdk:"project_uuid"`
+	Schedulers  []projectSchedulerModel `tfsdk:"schedulers"`
+}
+
+func (d *projectSchedulersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_schedulers"
+}
+
+func (d *projectSchedulersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_project_schedulers.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lists the scheduled deliveries of a Lightdash project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/schedulers`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"schedulers": schema.ListNestedAttribute{
+				MarkdownDescription: "The schedulers, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scheduler_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the scheduler.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the scheduler.",
+							Computed:            true,
+						},
+						"cron": schema.StringAttribute{
+							MarkdownDescription: "The cron expression of the deliveries, evaluated in the timezone of the scheduler or of the project.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "The format of the delivered data, e.g. `csv`, `image` or `gsheets`.",
+							Computed:            true,
+						},
+						"target_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the delivery targets: `email`, `slack`, `msteams` or `gsheets`. It is `mixed` when the scheduler delivers to several types of targets, and null when it has no target.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectSchedulersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *projectSchedulersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectSchedulersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	schedulers, err := apiv1.ListSchedulersInProjectV1(d.client, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash schedulers for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+	state.Schedulers = []projectSchedulerModel{}
+	for _, scheduler := range schedulers {
+		state.Schedulers = append(state.Schedulers, projectSchedulerModel{
+			SchedulerUUID: types.StringValue(scheduler.SchedulerUUID),
+			Name:          types.StringValue(scheduler.Name),
+			Cron:          types.StringValue(scheduler.Cron),
+			Format:        types.StringValue(scheduler.Format),
+			TargetType:    getSchedulerTargetType(scheduler),
+		})
+	}
+	sortByName(state.Schedulers,
+		func(scheduler projectSchedulerModel) types.String { return scheduler.Name },
+		func(scheduler projectSchedulerModel) types.String { return scheduler.SchedulerUUID })
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/schedulers", projectUuid))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// getSchedulerTargetType returns the type shared by the delivery targets of the scheduler.
+// Google Sheets deliveries write to a sheet instead of a target.
+func getSchedulerTargetType(scheduler apiv1.ListSchedulersInProjectV1Results) types.String {
+	if scheduler.Format == "gsheets" {
+		return types.StringValue("gsheets")
+	}
+	targetType := ""
+	for _, target := range scheduler.Targets {
+		current := ""
+		switch {
+		case target.SchedulerEmailTargetUUID != nil:
+			current = "email"
+		case target.SchedulerSlackTargetUUID != nil:
+			current = "slack"
+		case target.SchedulerMsTeamsTargetUUID != nil:
+			current = "msteams"
+		default:
+			continue
+		}
+		if targetType != "" && targetType != current {
+			return types.StringValue("mixed")
+		}
+		targetType = current
+	}
+	if targetType == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(targetType)
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestGetSchedulerTargetType(t *testing.T) {
+	targetUuid := "target-uuid"
+	email := apiv1.ListSchedulersInProjectV1Target{SchedulerEmailTargetUUID: &targetUuid}
+	slack := apiv1.ListSchedulersInProjectV1Target{SchedulerSlackTargetUUID: &targetUuid}
+	tests := []struct {
+		name      string
+		scheduler apiv1.ListSchedulersInProjectV1Results
+		expected  types.String
+	}{
+		{
+			name:      "email targets",
+			scheduler: apiv1.ListSchedulersInProjectV1Results{Format: "csv", Targets: []apiv1.ListSchedulersInProjectV1Target{email, email}},
+			expected:  types.StringValue("email"),
+		},
+		{
+			name:      "mixed targets",
+			scheduler: apiv1.ListSchedulersInProjectV1Results{Format: "image", Targets: []apiv1.ListSchedulersInProjectV1Target{email, slack}},
+			expected:  types.StringValue("mixed"),
+		},
+		{
+			name:      "google sheets",
+			scheduler: apiv1.ListSchedulersInProjectV1Results{Format: "gsheets"},
+			expected:  types.StringValue("gsheets"),
+		},
+		{
+			name:      "no target",
+			scheduler: apiv1.ListSchedulersInProjectV1Results{Format: "csv"},
+			expected:  types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSchedulerTargetType(tt.scheduler); !got.Equal(tt.expected) {
+				t.Errorf("getSchedulerTargetType() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectValidationDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectValidationDataSource{}
+)
+
+func NewProjectValidationDataSource() datasource.DataSource {
+	return &projectValidationDataSource{}
+}
+
+// projectValidationDataSource defines the data source implementation.
+type projectValidationDataSource struct {
+	client *api.Client
+}
+
+// projectValidationDataSourceModel describes the data source data model.
+type projectValidationDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectUUID          types.String `tfsdk:"project_uuid"`
+	ValidationErrorCount types.Int64  `tfsdk:"validation_error_count"`
+	LastValidatedAt      types.String `tfsdk:"last_validated_at"`
+}
+
+func (d *projectValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_validation"
+}
+
+func (d *projectValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_project_validation.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash project validation data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/validation`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"validation_error_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of errors found by the latest validation of the project.",
+				Computed:            true,
+			},
+			"last_validated_at": schema.StringAttribute{
+				MarkdownDescription: "The time of the latest validation error in RFC3339 format. It is null when there is no validation error.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *projectValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *projectValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectValidationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	results, err := apiv1.GetValidationResultsV1(d.client, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash validation results for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	state.ValidationErrorCount = types.Int64Value(int64(len(results)))
+	state.LastValidatedAt = types.StringPointerValue(getLastValidatedAt(results))
+
+	// Set resource ID
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/validation", projectUuid))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// getLastValidatedAt returns the latest creation time of the validation errors in RFC3339 format.
+// It returns nil when there is no error with a valid creation time.
+func getLastValidatedAt(results []apiv1.GetValidationResultsV1Results) *string {
+	var lastValidatedAt *time.Time
+	for _, result := range results {
+		createdAt, err := time.Parse(time.RFC3339, result.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if lastValidatedAt == nil || createdAt.After(*lastValidatedAt) {
+			lastValidatedAt = &createdAt
+		}
+	}
+	if lastValidatedAt == nil {
+		return nil
+	}
+	formatted := lastValidatedAt.UTC().Format(time.RFC3339)
+	return &formatted
+}
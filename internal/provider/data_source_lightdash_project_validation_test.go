@@ -0,0 +1,38 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestGetLastValidatedAt(t *testing.T) {
+	if got := getLastValidatedAt(nil); got != nil {
+		t.Errorf("getLastValidatedAt(nil) = %q, want nil", *got)
+	}
+
+	results := []apiv1.GetValidationResultsV1Results{
+		{CreatedAt: "2024-06-01T10:00:00.000Z"},
+		{CreatedAt: "2024-06-01T12:30:00+02:00"},
+		{CreatedAt: "2024-06-01T11:00:00Z"},
+		{CreatedAt: "not a timestamp"},
+	}
+	got := getLastValidatedAt(results)
+	if got == nil || *got != "2024-06-01T11:00:00Z" {
+		t.Errorf("getLastValidatedAt() = %v, want 2024-06-01T11:00:00Z", got)
+	}
+}
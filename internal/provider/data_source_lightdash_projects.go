@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -46,6 +45,7 @@ type nestedProjectModel struct {
 	ProjectUUID types.String `tfsdk:"project_uuid"`
 	ProjectName types.String `tfsdk:"name"`
 	ProjectType types.String `tfsdk:"type"`
+	ImportID    types.String `tfsdk:"import_id"`
 }
 
 // projectDataSourceModel describes the data source data model.
@@ -99,6 +99,10 @@ func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 							MarkdownDescription: "The type of the Lightdash project.",
 							Computed:            true,
 						},
+						"import_id": schema.StringAttribute{
+							MarkdownDescription: "The ID to import the project as a `lightdash_project` resource, i.e. `organizations/<organization_uuid>/projects/<project_uuid>`.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -131,6 +135,19 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// The organization UUID is needed to build the import IDs
+	if state.OrganizationUUID.IsNull() || state.OrganizationUUID.IsUnknown() {
+		organization, err := apiv1.GetMyOrganizationV1(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Lightdash organization",
+				err.Error(),
+			)
+			return
+		}
+		state.OrganizationUUID = types.StringValue(organization.OrganizationUUID)
+	}
+
 	projects, err := apiv1.ListOrganizationProjectsV1(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,13 +164,12 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			ProjectUUID: types.StringValue(project.ProjectUUID),
 			ProjectName: types.StringValue(project.ProjectName),
 			ProjectType: types.StringValue(project.ProjectType),
+			ImportID:    types.StringValue(getProjectResourceId(state.OrganizationUUID.ValueString(), project.ProjectUUID)),
 		}
 		updatedProjects = append(updatedProjects, projectState)
 	}
 	// Sort the projects by project UUID
-	sort.Slice(updatedProjects, func(i, j int) bool {
-		return updatedProjects[i].ProjectUUID.ValueString() < updatedProjects[j].ProjectUUID.ValueString()
-	})
+	sortByUUID(updatedProjects, func(item nestedProjectModel) types.String { return item.ProjectUUID })
 	state.Projects = updatedProjects
 
 	// Set resource ID
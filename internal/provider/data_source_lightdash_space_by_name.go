@@ -0,0 +1,181 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &spaceByNameDataSource{}
+	_ datasource.DataSourceWithConfigure = &spaceByNameDataSource{}
+)
+
+func NewSpaceByNameDataSource() datasource.DataSource {
+	return &spaceByNameDataSource{}
+}
+
+// spaceByNameDataSource defines the data source implementation.
+type spaceByNameDataSource struct {
+	client *api.Client
+}
+
+// spaceByNameDataSourceModel describes the data source data model.
+type spaceByNameDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectUUID     types.String `tfsdk:"project_uuid"`
+	Name            types.String `tfsdk:"name"`
+	SpaceUUID       types.String `tfsdk:"space_uuid"`
+	ParentSpaceUUID types.String `tfsdk:"parent_space_uuid"`
+	IsPrivate       types.Bool   `tfsdk:"is_private"`
+}
+
+func (d *spaceByNameDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_by_name"
+}
+
+func (d *spaceByNameDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_space_by_name.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Resolves a Lightdash space by its name within a project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `projects/<project_uuid>/spaces/<space_uuid>`.",
+				Computed:            true,
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The exact name of the space. It is case-sensitive.",
+				Required:            true,
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space.",
+				Computed:            true,
+			},
+			"parent_space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the parent space. It is null for root spaces.",
+				Computed:            true,
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Whether the space is private.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *spaceByNameDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *spaceByNameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spaceByNameDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUuid := state.ProjectUUID.ValueString()
+	spaces, err := apiv1.ListSpacesInProjectV1(d.client, projectUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Lightdash spaces for project UUID: "+projectUuid,
+			err.Error(),
+		)
+		return
+	}
+
+	// Ambiguous names are reported rather than picking one of the spaces
+	name := state.Name.ValueString()
+	matchedSpaces := findSpacesByName(spaces, name)
+	switch len(matchedSpaces) {
+	case 0:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Space not found",
+			fmt.Sprintf("No space named '%s' was found in project %s.", name, projectUuid),
+		)
+		return
+	case 1:
+	default:
+		spaceUuids := make([]string, 0, len(matchedSpaces))
+		for _, space := range matchedSpaces {
+			spaceUuids = append(spaceUuids, space.SpaceUUID)
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous space name",
+			fmt.Sprintf("%d spaces are named '%s' in project %s (UUIDs: %s). Please rename the spaces or use the lightdash_space data source with the UUID.", len(matchedSpaces), name, projectUuid, strings.Join(spaceUuids, ", ")),
+		)
+		return
+	}
+
+	space := matchedSpaces[0]
+	state.ID = types.StringValue(fmt.Sprintf("projects/%s/spaces/%s", projectUuid, space.SpaceUUID))
+	state.SpaceUUID = types.StringValue(space.SpaceUUID)
+	state.ParentSpaceUUID = types.StringPointerValue(space.ParentSpaceUUID)
+	state.IsPrivate = types.BoolValue(space.IsPrivate)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findSpacesByName returns the spaces with the exact name
+func findSpacesByName(spaces []apiv1.ListSpacesInProjectV1Results, name string) []apiv1.ListSpacesInProjectV1Results {
+	matchedSpaces := []apiv1.ListSpacesInProjectV1Results{}
+	for _, space := range spaces {
+		if space.SpaceName == name {
+			matchedSpaces = append(matchedSpaces, space)
+		}
+	}
+	return matchedSpaces
+}
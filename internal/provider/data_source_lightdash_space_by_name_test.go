@@ -0,0 +1,47 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestFindSpacesByName(t *testing.T) {
+	spaces := []apiv1.ListSpacesInProjectV1Results{
+		{SpaceUUID: "space-1", SpaceName: "Marketing"},
+		{SpaceUUID: "space-2", SpaceName: "Finance"},
+		{SpaceUUID: "space-3", SpaceName: "Finance"},
+	}
+
+	tests := []struct {
+		name     string
+		expected int
+	}{
+		{name: "Marketing", expected: 1},
+		{name: "Finance", expected: 2},
+		{name: "marketing", expected: 0},
+		{name: "Sales", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findSpacesByName(spaces, tt.name); len(got) != tt.expected {
+				t.Errorf("findSpacesByName(%q) returned %d spaces, want %d", tt.name, len(got), tt.expected)
+			}
+		})
+	}
+}
@@ -17,7 +17,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -169,9 +168,7 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		updatedSpaces = append(updatedSpaces, spaceState)
 	}
 	// Sort the spaces by space UUID
-	sort.Slice(updatedSpaces, func(i, j int) bool {
-		return updatedSpaces[i].SpaceUUID.ValueString() < updatedSpaces[j].SpaceUUID.ValueString()
-	})
+	sortByUUID(updatedSpaces, func(item spaceModel) types.String { return item.SpaceUUID })
 	state.Spaces = updatedSpaces
 
 	// Set resource ID
@@ -0,0 +1,124 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &warehouseCredentialsCheckEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &warehouseCredentialsCheckEphemeralResource{}
+)
+
+func NewWarehouseCredentialsCheckEphemeralResource() ephemeral.EphemeralResource {
+	return &warehouseCredentialsCheckEphemeralResource{}
+}
+
+// warehouseCredentialsCheckEphemeralResource defines the ephemeral resource implementation.
+// It only tests a connection, so there is nothing to renew or close.
+type warehouseCredentialsCheckEphemeralResource struct {
+	client *api.Client
+}
+
+// warehouseCredentialsCheckEphemeralResourceModel describes the ephemeral resource data model.
+type warehouseCredentialsCheckEphemeralResourceModel struct {
+	OrganizationWarehouseCredentialsUUID types.String `tfsdk:"organization_warehouse_credentials_uuid"`
+	Success                              types.Bool   `tfsdk:"success"`
+	Message                              types.String `tfsdk:"message"`
+}
+
+func (r *warehouseCredentialsCheckEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse_credentials_check"
+}
+
+func (r *warehouseCredentialsCheckEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/ephemeral_resources/ephemeral_resource_lightdash_warehouse_credentials_check.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Tests the connection of Lightdash warehouse credentials",
+		Attributes: map[string]schema.Attribute{
+			"organization_warehouse_credentials_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization warehouse credentials to test.",
+				Required:            true,
+			},
+			"success": schema.BoolAttribute{
+				MarkdownDescription: "Whether Lightdash connected to the warehouse with the credentials.",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The result of the test, e.g. the error returned by the warehouse when the connection failed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *warehouseCredentialsCheckEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+func (r *warehouseCredentialsCheckEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data warehouseCredentialsCheckEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A failed connection is reported in the result, so that pipelines can check it
+	credentialsUuid := data.OrganizationWarehouseCredentialsUUID.ValueString()
+	results, err := apiv1.TestOrganizationWarehouseCredentialsV1(r.client, credentialsUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to test Lightdash warehouse credentials",
+			fmt.Sprintf("Could not test warehouse credentials %s: %s", credentialsUuid, err.Error()),
+		)
+		return
+	}
+	data.Success = types.BoolValue(results.Success)
+	data.Message = types.StringValue(results.Message)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
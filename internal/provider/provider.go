@@ -16,10 +16,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -30,7 +35,10 @@ import (
 )
 
 // Ensure LightdashProvider satisfies various provider interfaces.
-var _ provider.Provider = &lightdashProvider{}
+var (
+	_ provider.Provider                       = &lightdashProvider{}
+	_ provider.ProviderWithEphemeralResources = &lightdashProvider{}
+)
 
 // lightdashProvider defines the provider implementation.
 type lightdashProvider struct {
@@ -44,7 +52,20 @@ type lightdashProvider struct {
 type lightdashProviderModel struct {
 	HostURL               types.String `tfsdk:"host"`
 	Token                 types.String `tfsdk:"token"`
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
 	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	CreateProjectJitterMs types.Int64  `tfsdk:"create_project_jitter_ms"`
+	MaxIdleConns          types.Int64  `tfsdk:"max_idle_conns"`
+	MaxIdleConnsPerHost   types.Int64  `tfsdk:"max_idle_conns_per_host"`
+	IdleConnTimeoutSec    types.Int64  `tfsdk:"idle_conn_timeout_seconds"`
+	ExtraHeaders          types.Map    `tfsdk:"extra_headers"`
+	MaxResponseSizeMB     types.Int64  `tfsdk:"max_response_size_mb"`
+	RetryBudgetSeconds    types.Int64  `tfsdk:"retry_budget_seconds"`
+	LogRetries            types.Bool   `tfsdk:"log_retries"`
+
+	DefaultWarehouseCredentialsUUID types.String `tfsdk:"default_warehouse_credentials_uuid"`
+	ConfigFile                      types.String `tfsdk:"config_file"`
 }
 
 func (p *lightdashProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,18 +78,69 @@ func (p *lightdashProvider) Schema(ctx context.Context, req provider.SchemaReque
 		Description: "A Terraform provider for Lightdash",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				MarkdownDescription: "Lightdash Host",
-				Required:            true,
+				MarkdownDescription: "Lightdash Host, e.g. `https://app.lightdash.cloud`. Trailing slashes are removed. Defaults to the `LIGHTDASH_URL` environment variable, then to `host` in the config file.",
+				Optional:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Personal access token for Lightdash",
-				Required:            true,
+				MarkdownDescription: "Personal access token for Lightdash. Required unless `client_id` and `client_secret` are set. When no credentials are set, it defaults to the `LIGHTDASH_API_KEY` environment variable, then to the credentials of the config file.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID of a Lightdash OAuth client. With `client_secret`, it is exchanged for short-lived access tokens (OAuth client credentials grant) instead of using a personal access token.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of a Lightdash OAuth client. Required with `client_id`.",
+				Optional:            true,
 				Sensitive:           true,
 			},
 			"max_concurrent_requests": schema.Int64Attribute{
 				MarkdownDescription: "Maximum number of concurrent requests to the Lightdash API. Defaults to 10.",
 				Optional:            true,
 			},
+			"create_project_jitter_ms": schema.Int64Attribute{
+				MarkdownDescription: "Maximum random delay in milliseconds before each project creation, to spread out many concurrent creations. Defaults to 0 (disabled).",
+				Optional:            true,
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of idle (keep-alive) connections. Defaults to %d.", api.DefaultMaxIdleConns),
+				Optional:            true,
+			},
+			"max_idle_conns_per_host": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of idle (keep-alive) connections to the Lightdash host. Defaults to %d.", api.DefaultMaxIdleConnsPerHost),
+				Optional:            true,
+			},
+			"idle_conn_timeout_seconds": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of seconds an idle (keep-alive) connection is kept open. Defaults to %d.", int64(api.DefaultIdleConnTimeout/time.Second)),
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, e.g. for an authentication proxy in front of Lightdash. The values are masked in the logs.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"max_response_size_mb": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum size in megabytes of a response of the Lightdash API, e.g. for huge data catalogs. Larger responses fail instead of exhausting the memory. Defaults to %d.", api.DefaultMaxResponseSize>>20),
+				Optional:            true,
+			},
+			"retry_budget_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Maximum total number of seconds spent waiting for retries of transient errors, shared by all the requests of a run. Once it is spent, requests fail without being retried, so that an outage fails the apply promptly. Defaults to unlimited.",
+				Optional:            true,
+			},
+			"log_retries": schema.BoolAttribute{
+				MarkdownDescription: "Whether each retry of a request after a transient error is logged as a warning, with the attempt number, the delay and the reason of the retry. Defaults to true.",
+				Optional:            true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a YAML file with the `host`, `token`, `client_id` and `client_secret` of the provider, e.g. for local development. Defaults to `~/.lightdash/config.yaml` when it exists. Unknown keys are only rejected in a file set with this attribute, so the keys of other tools in the default file are ignored. The provider attributes and the environment variables take precedence over the file.",
+				Optional:            true,
+			},
+			"default_warehouse_credentials_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization warehouse credentials used by `lightdash_project` resources that set neither `warehouse_connection` nor `organization_warehouse_credentials_uuid`. It is not used by projects on another `host`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -99,10 +171,80 @@ func (p *lightdashProvider) Configure(ctx context.Context, req provider.Configur
 		)
 		return
 	}
+	if config.ClientID.IsUnknown() || config.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Unknown Lightdash OAuth Client",
+			"Please set the `client_id` and `client_secret` attributes to known values.",
+		)
+		return
+	}
+	if config.ConfigFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_file"),
+			"Unknown Lightdash Config File",
+			"Please set the `config_file` attribute to a known path.",
+		)
+		return
+	}
+
+	// Fill the settings missing from the configuration with the environment variables and the config file
+	configFile, err := readProviderConfigFile(config.ConfigFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_file"),
+			"Invalid Lightdash Config File",
+			err.Error(),
+		)
+		return
+	}
+	applyProviderConfigSources(&config, configFile)
+	if config.HostURL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Missing Lightdash API Host",
+			"Please set the `host` attribute, the LIGHTDASH_URL environment variable or `host` in the config file.",
+		)
+		return
+	}
+
+	// Requests are authenticated either with a personal access token or with OAuth client credentials
+	useOAuth := !config.ClientID.IsNull() || !config.ClientSecret.IsNull()
+	switch {
+	case useOAuth && !config.Token.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Conflicting Lightdash Credentials",
+			"Please set either the `token` attribute or the `client_id` and `client_secret` attributes, not both.",
+		)
+		return
+	case useOAuth && (config.ClientID.IsNull() || config.ClientSecret.IsNull()):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Incomplete Lightdash OAuth Client",
+			"Please set both the `client_id` and `client_secret` attributes.",
+		)
+		return
+	case !useOAuth && config.Token.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Missing Lightdash API Token",
+			"Please set the `token` attribute, or the `client_id` and `client_secret` attributes. They can also be set with the LIGHTDASH_API_KEY environment variable or in the config file.",
+		)
+		return
+	}
 
 	// Configuration values are now available.
 	// if data.Endpoint.IsNull() { /* ... */ }
-	host := config.HostURL.ValueString()
+	host, err := normalizeHostUrl(config.HostURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid Lightdash API Host",
+			fmt.Sprintf("Please set the `host` attribute to an http(s) URL such as `https://app.lightdash.cloud`: %s", err.Error()),
+		)
+		return
+	}
 	token := config.Token.ValueString()
 	var maxConcurrentRequests *int64
 	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
@@ -110,30 +252,110 @@ func (p *lightdashProvider) Configure(ctx context.Context, req provider.Configur
 		maxConcurrentRequests = &val
 	}
 	client, _ := api.NewClient(&host, &token, maxConcurrentRequests)
+	if !config.CreateProjectJitterMs.IsNull() && !config.CreateProjectJitterMs.IsUnknown() {
+		if config.CreateProjectJitterMs.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("create_project_jitter_ms"),
+				"Invalid project creation jitter",
+				"Please set the `create_project_jitter_ms` attribute to 0 or a positive number of milliseconds.",
+			)
+			return
+		}
+		client.CreateProjectMaxJitter = time.Duration(config.CreateProjectJitterMs.ValueInt64()) * time.Millisecond
+	}
+	if useOAuth {
+		client.SetOAuthClientCredentials(config.ClientID.ValueString(), config.ClientSecret.ValueString())
+	}
+	client.DefaultWarehouseCredentialsUUID = config.DefaultWarehouseCredentialsUUID.ValueString()
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		extraHeaders := map[string]string{}
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		client.ExtraHeaders = extraHeaders
+	}
 
-	// Check if the token is valid as long as the test mode is not disabled
-	if !isIntegrationTestMode() {
-		_, err := apiv1.GetMyOrganizationV1(client)
-		if err != nil {
+	if !config.MaxResponseSizeMB.IsNull() && !config.MaxResponseSizeMB.IsUnknown() {
+		if config.MaxResponseSizeMB.ValueInt64() <= 0 {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("token"),
-				"Invalid Lightdash API Token",
-				"Please set the valid `token` attribute to the Lightdash API Token.",
+				path.Root("max_response_size_mb"),
+				"Invalid maximum response size",
+				"Please set the `max_response_size_mb` attribute to a positive number of megabytes.",
+			)
+			return
+		}
+		client.MaxResponseSize = config.MaxResponseSizeMB.ValueInt64() << 20
+	}
+
+	if !config.RetryBudgetSeconds.IsNull() && !config.RetryBudgetSeconds.IsUnknown() {
+		if config.RetryBudgetSeconds.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_budget_seconds"),
+				"Invalid retry budget",
+				"Please set the `retry_budget_seconds` attribute to 0 or a positive number of seconds.",
+			)
+			return
+		}
+		client.SetRetryBudget(time.Duration(config.RetryBudgetSeconds.ValueInt64()) * time.Second)
+	}
+	if !config.LogRetries.IsNull() && !config.LogRetries.IsUnknown() {
+		client.LogRetries = config.LogRetries.ValueBool()
+	}
+
+	// Tune the connection pool
+	maxIdleConns := int64(api.DefaultMaxIdleConns)
+	maxIdleConnsPerHost := int64(api.DefaultMaxIdleConnsPerHost)
+	idleConnTimeoutSec := int64(api.DefaultIdleConnTimeout / time.Second)
+	for _, option := range []struct {
+		attribute string
+		config    types.Int64
+		value     *int64
+	}{
+		{"max_idle_conns", config.MaxIdleConns, &maxIdleConns},
+		{"max_idle_conns_per_host", config.MaxIdleConnsPerHost, &maxIdleConnsPerHost},
+		{"idle_conn_timeout_seconds", config.IdleConnTimeoutSec, &idleConnTimeoutSec},
+	} {
+		if option.config.IsNull() || option.config.IsUnknown() {
+			continue
+		}
+		if option.config.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(option.attribute),
+				"Invalid connection pool setting",
+				fmt.Sprintf("Please set the `%s` attribute to 0 or a positive number.", option.attribute),
 			)
 			return
 		}
+		*option.value = option.config.ValueInt64()
+	}
+	client.SetConnectionPool(int(maxIdleConns), int(maxIdleConnsPerHost), time.Duration(idleConnTimeoutSec)*time.Second)
+
+	// Check if the host and the token are valid as long as the test mode is not disabled
+	if !isIntegrationTestMode() {
+		checkLightdashConnection(client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *lightdashProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewOrganizationRoleMemberResource,
+		NewOrganizationSettingsResource,
 		NewProjectRoleMemberResource,
 		NewSpaceResource,
+		NewSpaceContentResource,
+		NewProjectPinnedItemsOrderResource,
+		NewProjectSemanticLayerResource,
+		NewProjectRefreshScheduleResource,
 		NewGroupResource,
+		NewGroupMembershipSyncResource,
 		NewProjectRoleGroupResource,
 		NewProjectSchedulerSettingsResource,
 		NewProjectAgentResource,
@@ -146,27 +368,45 @@ func (p *lightdashProvider) Resources(ctx context.Context) []func() resource.Res
 
 func (p *lightdashProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewApiStatusDataSource,
+		NewHealthDataSource,
 		NewAuthenticatedUserDataSource,
+		NewConnectionTypesDataSource,
 		NewGroupDataSource,
 		NewGroupMembersDataSource,
 		NewOrganizationDataSource,
 		NewOrganizationGroupsDataSource,
 		NewOrganizationMemberDataSource,
 		NewOrganizationMembersDataSource,
+		NewOrganizationSeatUsageDataSource,
 		NewOrganizationMembersByEmailsDataSource,
 		NewProjectDataSource,
 		NewProjectAgentDataSource,
+		NewProjectExploresDataSource,
+		NewProjectDataCatalogDataSource,
+		NewProjectValidationDataSource,
 		NewProjectsDataSource,
 		NewProjectMembersDataSource,
+		NewProjectEffectiveRoleDataSource,
 		NewProjectGroupAccessesDataSource,
 		NewProjectSchedulerSettingsDataSource,
+		NewProjectSchedulersDataSource,
 		NewSpacesDataSource,
 		NewSpaceDataSource,
+		NewSpaceByNameDataSource,
+		NewDashboardsDataSource,
+		NewChartsDataSource,
 		NewOrganizationAgentsDataSource,
 		NewPersonalAccessTokensDataSource,
 	}
 }
 
+func (p *lightdashProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewWarehouseCredentialsCheckEphemeralResource,
+	}
+}
+
 func (p *lightdashProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewNormalizeProjectMembersFunction,
@@ -180,3 +420,49 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// checkLightdashConnection checks the host with the health endpoint, then the token,
+// so that a misconfiguration is reported clearly instead of failing the first resource operation.
+func checkLightdashConnection(client *api.Client, diagnostics *diag.Diagnostics) {
+	if _, err := apiv1.GetHealthV1(client); err != nil {
+		statusCode, hasStatusCode := api.StatusCodeOf(err)
+		switch {
+		case api.IsNetworkError(err):
+			diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Unreachable Lightdash API Host",
+				fmt.Sprintf("Could not reach the Lightdash API at %s. Please check the `host` attribute and the network: %s", client.HostUrl, err.Error()),
+			)
+		case hasStatusCode && statusCode == http.StatusNotFound, !hasStatusCode:
+			diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Wrong Lightdash API Host",
+				fmt.Sprintf("%s doesn't serve the Lightdash API. Please set the `host` attribute to the base URL of Lightdash, without any path such as `/api/v1`: %s", client.HostUrl, err.Error()),
+			)
+		default:
+			diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Unhealthy Lightdash API Host",
+				fmt.Sprintf("The Lightdash API at %s is not healthy: %s", client.HostUrl, err.Error()),
+			)
+		}
+		return
+	}
+
+	if _, err := apiv1.GetMyOrganizationV1(client); err != nil {
+		statusCode, _ := api.StatusCodeOf(err)
+		if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+			diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Invalid Lightdash API Token",
+				fmt.Sprintf("Please set the valid `token` attribute to the Lightdash API Token. The token was rejected with status code %d.", statusCode),
+			)
+			return
+		}
+		diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Invalid Lightdash API Token",
+			fmt.Sprintf("Please set the valid `token` attribute to the Lightdash API Token. Could not get the organization of the token: %s", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigFilePath is the path of the configuration file relative to the home directory
+var defaultConfigFilePath = filepath.Join(".lightdash", "config.yaml")
+
+// providerConfigFile describes the configuration file of the provider, e.g. for local development
+type providerConfigFile struct {
+	Host         string `yaml:"host"`
+	Token        string `yaml:"token"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+}
+
+// readProviderConfigFile reads the configuration file at the path, or at `~/.lightdash/config.yaml` when the path is empty.
+// Only an explicit path must exist, so a missing default file returns an empty configuration.
+// Unknown keys are only rejected in an explicit file, since the default file may be shared with other tools.
+func readProviderConfigFile(path string) (*providerConfigFile, error) {
+	explicit := path != ""
+	if !explicit {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return &providerConfigFile{}, nil
+		}
+		path = filepath.Join(home, defaultConfigFilePath)
+	}
+
+	content, err := os.ReadFile(path) // #nosec G304 -- the path is configured by the user running Terraform.
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return &providerConfigFile{}, nil
+		}
+		return nil, fmt.Errorf("could not read the config file %s: %w", path, err)
+	}
+
+	configFile := &providerConfigFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(explicit)
+	if err := decoder.Decode(configFile); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("could not parse the config file %s: %w", path, err)
+	}
+	return configFile, nil
+}
+
+// applyProviderConfigSources fills the connection settings missing from the provider configuration.
+// The provider attributes take precedence over the environment variables, which take precedence over the config file.
+// The credentials are taken from a single source, so a token and an OAuth client from different sources never conflict.
+func applyProviderConfigSources(config *lightdashProviderModel, configFile *providerConfigFile) {
+	if config.HostURL.IsNull() {
+		config.HostURL = firstNonEmptyString(os.Getenv(lightdashUrlEnvVar), configFile.Host)
+	}
+
+	if !config.Token.IsNull() || !config.ClientID.IsNull() || !config.ClientSecret.IsNull() {
+		return
+	}
+	if token := strings.TrimSpace(os.Getenv(lightdashApiKeyEnvVar)); token != "" {
+		config.Token = types.StringValue(token)
+		return
+	}
+	config.Token = firstNonEmptyString(configFile.Token)
+	config.ClientID = firstNonEmptyString(configFile.ClientID)
+	config.ClientSecret = firstNonEmptyString(configFile.ClientSecret)
+}
+
+// firstNonEmptyString returns the first non-empty value, or null when all of them are empty
+func firstNonEmptyString(values ...string) types.String {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return types.StringValue(value)
+		}
+	}
+	return types.StringNull()
+}
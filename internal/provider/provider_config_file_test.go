@@ -0,0 +1,105 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadProviderConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	// A missing default file is an empty configuration
+	configFile, err := readProviderConfigFile("")
+	if err != nil || *configFile != (providerConfigFile{}) {
+		t.Errorf("readProviderConfigFile() = %+v, %v, want an empty configuration", configFile, err)
+	}
+
+	// A missing explicit file is an error
+	if _, err := readProviderConfigFile(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("Expected an error for a missing config file")
+	}
+
+	// The default file is read when it exists, even with the keys of other tools
+	if err := os.MkdirAll(filepath.Join(dir, ".lightdash"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	content := "host: https://app.lightdash.cloud\ntoken: file-token\nproject: other-tool-setting\n"
+	if err := os.WriteFile(filepath.Join(dir, ".lightdash", "config.yaml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	configFile, err = readProviderConfigFile("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if configFile.Host != "https://app.lightdash.cloud" || configFile.Token != "file-token" {
+		t.Errorf("readProviderConfigFile() = %+v, want the host and the token of the file", configFile)
+	}
+
+	// The unknown keys are ignored in the default file, but not when the same file is set explicitly
+	if _, err := readProviderConfigFile(filepath.Join(dir, ".lightdash", "config.yaml")); err == nil {
+		t.Error("Expected an error for an unknown key in an explicit config file")
+	}
+
+	// Unknown keys are rejected to catch typos
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalidPath, []byte("hots: https://app.lightdash.cloud\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readProviderConfigFile(invalidPath); err == nil {
+		t.Error("Expected an error for an unknown key")
+	}
+}
+
+func TestApplyProviderConfigSources(t *testing.T) {
+	configFile := &providerConfigFile{
+		Host:         "https://file.lightdash.cloud",
+		ClientID:     "file-client-id",
+		ClientSecret: "file-client-secret",
+	}
+
+	// The config file is the last resort
+	t.Setenv(lightdashUrlEnvVar, "")
+	t.Setenv(lightdashApiKeyEnvVar, "")
+	config := lightdashProviderModel{}
+	applyProviderConfigSources(&config, configFile)
+	if config.HostURL.ValueString() != "https://file.lightdash.cloud" || config.ClientID.ValueString() != "file-client-id" || !config.Token.IsNull() {
+		t.Errorf("applyProviderConfigSources() = %+v, want the settings of the config file", config)
+	}
+
+	// The environment variables take precedence over the config file, for the credentials as a whole
+	t.Setenv(lightdashUrlEnvVar, "https://env.lightdash.cloud")
+	t.Setenv(lightdashApiKeyEnvVar, "env-token")
+	config = lightdashProviderModel{}
+	applyProviderConfigSources(&config, configFile)
+	if config.HostURL.ValueString() != "https://env.lightdash.cloud" || config.Token.ValueString() != "env-token" || !config.ClientID.IsNull() {
+		t.Errorf("applyProviderConfigSources() = %+v, want the settings of the environment variables", config)
+	}
+
+	// The provider attributes take precedence over everything
+	config = lightdashProviderModel{
+		HostURL: types.StringValue("https://attribute.lightdash.cloud"),
+		Token:   types.StringValue("attribute-token"),
+	}
+	applyProviderConfigSources(&config, configFile)
+	if config.HostURL.ValueString() != "https://attribute.lightdash.cloud" || config.Token.ValueString() != "attribute-token" || !config.ClientID.IsNull() {
+		t.Errorf("applyProviderConfigSources() = %+v, want the provider attributes", config)
+	}
+}
@@ -17,6 +17,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
 )
 
@@ -51,11 +54,12 @@ type groupResource struct {
 
 // groupResourceModel describes the resource data model.
 type groupResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationUUID types.String `tfsdk:"organization_uuid"`
-	GroupUUID        types.String `tfsdk:"group_uuid"`
-	Name             types.String `tfsdk:"name"`
-	Members          types.Set    `tfsdk:"members"`
+	ID                types.String `tfsdk:"id"`
+	OrganizationUUID  types.String `tfsdk:"organization_uuid"`
+	GroupUUID         types.String `tfsdk:"group_uuid"`
+	Name              types.String `tfsdk:"name"`
+	Members           types.Set    `tfsdk:"members"`
+	RequireUniqueName types.Bool   `tfsdk:"require_unique_name"`
 }
 
 type groupMemberModelForGroup struct {
@@ -102,6 +106,10 @@ func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The name of the Lightdash group.",
 				Required:            true,
 			},
+			"require_unique_name": schema.BoolAttribute{
+				MarkdownDescription: "Whether creating or renaming the group fails when another group of the organization has the same name. Lightdash allows duplicate group names, which break lookups by name. Defaults to `false`.",
+				Optional:            true,
+			},
 			// TODO check if values of userUUID are unique
 			"members": schema.SetNestedAttribute{
 				MarkdownDescription: "A set of user UUIDs who are members of the group.",
@@ -164,6 +172,11 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		})
 	}
 
+	// Guard against duplicate names, which Lightdash allows
+	if plan.RequireUniqueName.ValueBool() && !r.checkUniqueGroupName(ctx, group_name, "", &resp.Diagnostics) {
+		return
+	}
+
 	// Create new group
 	createdGroup, err := apiv1.CreateGroupInOrganizationV1(r.client, organization_uuid, group_name, members)
 	if err != nil {
@@ -312,6 +325,11 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Guard against renaming the group to the name of another group
+	if plan.RequireUniqueName.ValueBool() && groupName != state.Name.ValueString() && !r.checkUniqueGroupName(ctx, groupName, groupUuid, &resp.Diagnostics) {
+		return
+	}
+
 	updatedMembers := []groupMemberModelForGroup{}
 	removedMembers := []groupMemberModelForGroup{}
 
@@ -511,6 +529,40 @@ func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStat
 	))...)
 }
 
+// checkUniqueGroupName checks that no other group of the organization has the name, except the group to exclude.
+// It adds an error and returns false otherwise.
+func (r *groupResource) checkUniqueGroupName(ctx context.Context, name string, excludedGroupUuid string, diagnostics *diag.Diagnostics) bool {
+	groups, err := services.NewOrganizationGroupsService(r.client).GetOrganizationGroups(ctx)
+	if err != nil {
+		diagnostics.AddError(
+			"Error listing groups",
+			fmt.Sprintf("Could not list the groups of the organization to check that the name '%s' is unique: %s", name, err.Error()),
+		)
+		return false
+	}
+	if duplicateUuids := findGroupUuidsByName(groups, name, excludedGroupUuid); len(duplicateUuids) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Duplicate group name",
+			fmt.Sprintf("The organization already has a group named '%s' (UUID: %s) and require_unique_name is true. Please choose another name or import the existing group.", name, strings.Join(duplicateUuids, ", ")),
+		)
+		return false
+	}
+	return true
+}
+
+// findGroupUuidsByName returns the sorted UUIDs of the groups with the name, except the group to exclude
+func findGroupUuidsByName(groups []models.OrganizationGroup, name string, excludedGroupUuid string) []string {
+	groupUuids := []string{}
+	for _, group := range groups {
+		if group.Name == name && group.GroupUUID != excludedGroupUuid {
+			groupUuids = append(groupUuids, group.GroupUUID)
+		}
+	}
+	sort.Strings(groupUuids)
+	return groupUuids
+}
+
 func getGroupResourceId(organization_uuid string, group_uuid string) string {
 	// Return the resource ID
 	return fmt.Sprintf("organizations/%s/groups/%s", organization_uuid, group_uuid)
@@ -0,0 +1,482 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &groupMembershipSyncResource{}
+	_ resource.ResourceWithConfigure      = &groupMembershipSyncResource{}
+	_ resource.ResourceWithModifyPlan     = &groupMembershipSyncResource{}
+	_ resource.ResourceWithValidateConfig = &groupMembershipSyncResource{}
+)
+
+func NewGroupMembershipSyncResource() resource.Resource {
+	return &groupMembershipSyncResource{}
+}
+
+// groupMembershipSyncResource defines the resource implementation.
+type groupMembershipSyncResource struct {
+	client *api.Client
+}
+
+// groupMembershipSyncResourceModel describes the resource data model.
+type groupMembershipSyncResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	GroupUUID           types.String `tfsdk:"group_uuid"`
+	MemberEmails        types.Set    `tfsdk:"member_emails"`
+	MemberUUIDs         types.Set    `tfsdk:"member_uuids"`
+	UnmanagedMembers    types.Set    `tfsdk:"unmanaged_members"`
+	ResolvedMemberUUIDs types.Set    `tfsdk:"resolved_member_uuids"`
+}
+
+func (r *groupMembershipSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership_sync"
+}
+
+func (r *groupMembershipSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_group_membership_sync.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Synchronizes the members of a Lightdash group with an authoritative list",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `groups/<group_uuid>/membership_sync`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash group whose members are synchronized.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_emails": schema.SetAttribute{
+				MarkdownDescription: "The emails of the users who must be members of the group. The emails are resolved to the users of the organization. At least one of `member_emails` and `member_uuids` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"member_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the users who must be members of the group. At least one of `member_emails` and `member_uuids` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"unmanaged_members": schema.SetAttribute{
+				MarkdownDescription: "The emails or UUIDs of users whose membership of the group is never added nor removed by the synchronization.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"resolved_member_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the members of the group which are managed by the synchronization.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *groupMembershipSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *groupMembershipSyncResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without any list, the synchronization would remove every member of the group
+	if config.MemberEmails.IsNull() && config.MemberUUIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_emails"),
+			"Missing group members",
+			"At least one of member_emails and member_uuids must be set. Set one of them to an empty set to remove every managed member of the group.",
+		)
+	}
+}
+
+func (r *groupMembershipSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is resolved when the synchronization is destroyed, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.MemberEmails.IsUnknown() || plan.MemberUUIDs.IsUnknown() || plan.UnmanagedMembers.IsUnknown() {
+		return
+	}
+
+	// Planning the resolved members makes the drift of the group members visible
+	desiredMembers, _ := r.resolveDesiredMembers(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resolvedMembers, diags := types.SetValueFrom(ctx, types.StringType, desiredMembers)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_member_uuids"), resolvedMembers)...)
+}
+
+func (r *groupMembershipSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncGroupMembers(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	plan.ID = types.StringValue(getGroupMembershipSyncResourceId(plan.GroupUUID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupMembershipSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get group members
+	groupUuid := state.GroupUUID.ValueString()
+	groupMembers, err := apiv1.GetGroupMembersV1(r.client, groupUuid)
+	if err != nil {
+		// If the group is not found, remove the synchronization from state
+		if statusCode, _ := api.StatusCodeOf(err); statusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Getting group members",
+			fmt.Sprintf("Could not get members for group %s: %s", groupUuid, err.Error()),
+		)
+		return
+	}
+
+	unmanagedMembers := r.resolveUnmanagedMembers(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the managed members are tracked, so that the unmanaged members never cause a drift
+	managedMembers := []string{}
+	for _, member := range groupMembers {
+		if _, unmanaged := unmanagedMembers[member.UserUUID]; !unmanaged {
+			managedMembers = append(managedMembers, member.UserUUID)
+		}
+	}
+	sort.Strings(managedMembers)
+
+	resolvedMembers, diags := types.SetValueFrom(ctx, types.StringType, managedMembers)
+	resp.Diagnostics.Append(diags...)
+	state.ResolvedMemberUUIDs = resolvedMembers
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupMembershipSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncGroupMembers(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupMembershipSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state groupMembershipSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managedMembers []string
+	resp.Diagnostics.Append(state.ResolvedMemberUUIDs.ElementsAs(ctx, &managedMembers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the managed members, and leave the unmanaged members in the group
+	groupUuid := state.GroupUUID.ValueString()
+	for _, userUuid := range managedMembers {
+		tflog.Info(ctx, fmt.Sprintf("Revoking access to group %s for user %s", groupUuid, userUuid))
+		if err := apiv1.RemoveUserFromGroupV1(r.client, groupUuid, userUuid); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Revoking access to group",
+				fmt.Sprintf("Could not revoke access to group %s for user %s, unexpected error: %s", groupUuid, userUuid, err.Error()),
+			)
+		}
+	}
+}
+
+// syncGroupMembers adds the missing members to the group and removes the members which are neither desired nor unmanaged.
+// The resolved members of the model are set to the desired members.
+func (r *groupMembershipSyncResource) syncGroupMembers(ctx context.Context, model *groupMembershipSyncResourceModel, diagnostics *diag.Diagnostics) {
+	desiredMembers, unmanagedMembers := r.resolveDesiredMembers(ctx, model, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// Get group members
+	groupUuid := model.GroupUUID.ValueString()
+	groupMembers, err := apiv1.GetGroupMembersV1(r.client, groupUuid)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Getting group members",
+			fmt.Sprintf("Could not get members for group %s: %s", groupUuid, err.Error()),
+		)
+		return
+	}
+	currentMembers := make([]string, len(groupMembers))
+	for i, member := range groupMembers {
+		currentMembers[i] = member.UserUUID
+	}
+
+	addedMembers, removedMembers := diffGroupMembership(currentMembers, desiredMembers, unmanagedMembers)
+	for _, userUuid := range addedMembers {
+		tflog.Info(ctx, fmt.Sprintf("Granting access to group %s for user %s", groupUuid, userUuid))
+		if err := apiv1.AddUserToGroupV1(r.client, groupUuid, userUuid); err != nil {
+			diagnostics.AddError(
+				"Error Granting access to group",
+				fmt.Sprintf("Could not grant access to group %s for user %s, unexpected error: %s", groupUuid, userUuid, err.Error()),
+			)
+		}
+	}
+	for _, userUuid := range removedMembers {
+		tflog.Info(ctx, fmt.Sprintf("Revoking access to group %s for user %s", groupUuid, userUuid))
+		if err := apiv1.RemoveUserFromGroupV1(r.client, groupUuid, userUuid); err != nil {
+			diagnostics.AddError(
+				"Error Revoking access to group",
+				fmt.Sprintf("Could not revoke access to group %s for user %s, unexpected error: %s", groupUuid, userUuid, err.Error()),
+			)
+		}
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	resolvedMembers, diags := types.SetValueFrom(ctx, types.StringType, desiredMembers)
+	diagnostics.Append(diags...)
+	model.ResolvedMemberUUIDs = resolvedMembers
+}
+
+// resolveDesiredMembers resolves the member emails and UUIDs of the model to the sorted UUIDs of the desired members,
+// and returns them with the UUIDs of the unmanaged members.
+func (r *groupMembershipSyncResource) resolveDesiredMembers(ctx context.Context, model *groupMembershipSyncResourceModel, diagnostics *diag.Diagnostics) ([]string, map[string]struct{}) {
+	var memberEmails, memberUuids []string
+	diagnostics.Append(model.MemberEmails.ElementsAs(ctx, &memberEmails, false)...)
+	diagnostics.Append(model.MemberUUIDs.ElementsAs(ctx, &memberUuids, false)...)
+	if diagnostics.HasError() {
+		return nil, nil
+	}
+
+	organizationMembers, err := services.GetOrganizationMembersService(r.client).GetOrganizationMembersByCache(ctx)
+	if err != nil {
+		diagnostics.AddError(
+			"Error getting organization members",
+			fmt.Sprintf("Could not get organization members: %s", err.Error()),
+		)
+		return nil, nil
+	}
+
+	unmanagedMembers := r.resolveUnmanagedMembers(ctx, model, diagnostics)
+	if diagnostics.HasError() {
+		return nil, nil
+	}
+
+	resolvedEmails, unresolvedEmails := resolveOrganizationMemberUuids(organizationMembers, memberEmails)
+	for _, email := range unresolvedEmails {
+		diagnostics.AddAttributeError(
+			path.Root("member_emails"),
+			"Unknown member email",
+			fmt.Sprintf("No user of the organization has the email %s.", email),
+		)
+	}
+	resolvedUuids, unresolvedUuids := resolveOrganizationMemberUuids(organizationMembers, memberUuids)
+	for _, userUuid := range unresolvedUuids {
+		diagnostics.AddAttributeError(
+			path.Root("member_uuids"),
+			"Unknown member UUID",
+			fmt.Sprintf("No user of the organization has the UUID %s.", userUuid),
+		)
+	}
+	if diagnostics.HasError() {
+		return nil, nil
+	}
+
+	desiredMembers := []string{}
+	seen := make(map[string]struct{})
+	for _, userUuid := range append(resolvedEmails, resolvedUuids...) {
+		if _, unmanaged := unmanagedMembers[userUuid]; unmanaged {
+			continue
+		}
+		if _, duplicated := seen[userUuid]; duplicated {
+			continue
+		}
+		seen[userUuid] = struct{}{}
+		desiredMembers = append(desiredMembers, userUuid)
+	}
+	sort.Strings(desiredMembers)
+	return desiredMembers, unmanagedMembers
+}
+
+// resolveUnmanagedMembers resolves the unmanaged members of the model to user UUIDs.
+// Unmanaged members which are not users of the organization are ignored, as they can't be members of the group.
+func (r *groupMembershipSyncResource) resolveUnmanagedMembers(ctx context.Context, model *groupMembershipSyncResourceModel, diagnostics *diag.Diagnostics) map[string]struct{} {
+	var references []string
+	diagnostics.Append(model.UnmanagedMembers.ElementsAs(ctx, &references, false)...)
+	if diagnostics.HasError() || len(references) == 0 {
+		return map[string]struct{}{}
+	}
+
+	organizationMembers, err := services.GetOrganizationMembersService(r.client).GetOrganizationMembersByCache(ctx)
+	if err != nil {
+		diagnostics.AddError(
+			"Error getting organization members",
+			fmt.Sprintf("Could not get organization members: %s", err.Error()),
+		)
+		return nil
+	}
+
+	resolved, _ := resolveOrganizationMemberUuids(organizationMembers, references)
+	unmanagedMembers := make(map[string]struct{}, len(resolved))
+	for _, userUuid := range resolved {
+		unmanagedMembers[userUuid] = struct{}{}
+	}
+	return unmanagedMembers
+}
+
+// resolveOrganizationMemberUuids resolves emails or user UUIDs to the user UUIDs of the organization members.
+// Emails are compared case-insensitively. The references which match no member are returned separately.
+func resolveOrganizationMemberUuids(organizationMembers []apiv1.GetOrganizationMembersV1Results, references []string) ([]string, []string) {
+	resolved := []string{}
+	unresolved := []string{}
+	for _, reference := range references {
+		found := false
+		for _, member := range organizationMembers {
+			if reference == member.UserUUID || strings.EqualFold(reference, member.Email) {
+				resolved = append(resolved, member.UserUUID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, reference)
+		}
+	}
+	return resolved, unresolved
+}
+
+// diffGroupMembership returns the sorted members to add to and to remove from the group.
+// The unmanaged members are never removed.
+func diffGroupMembership(currentMembers []string, desiredMembers []string, unmanagedMembers map[string]struct{}) ([]string, []string) {
+	currentMap := make(map[string]struct{}, len(currentMembers))
+	for _, userUuid := range currentMembers {
+		currentMap[userUuid] = struct{}{}
+	}
+	desiredMap := make(map[string]struct{}, len(desiredMembers))
+	for _, userUuid := range desiredMembers {
+		desiredMap[userUuid] = struct{}{}
+	}
+
+	addedMembers := []string{}
+	for userUuid := range desiredMap {
+		if _, exists := currentMap[userUuid]; !exists {
+			addedMembers = append(addedMembers, userUuid)
+		}
+	}
+	removedMembers := []string{}
+	for userUuid := range currentMap {
+		_, desired := desiredMap[userUuid]
+		_, unmanaged := unmanagedMembers[userUuid]
+		if !desired && !unmanaged {
+			removedMembers = append(removedMembers, userUuid)
+		}
+	}
+	sort.Strings(addedMembers)
+	sort.Strings(removedMembers)
+	return addedMembers, removedMembers
+}
+
+func getGroupMembershipSyncResourceId(group_uuid string) string {
+	// Return the resource ID
+	return fmt.Sprintf("groups/%s/membership_sync", group_uuid)
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestGroupMembershipSyncValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		attributes  map[string]attr.Value
+		expectError bool
+	}{
+		{
+			name:        "no member list",
+			attributes:  map[string]attr.Value{"group_uuid": types.StringValue("group-uuid")},
+			expectError: true,
+		},
+		{
+			name: "member emails",
+			attributes: map[string]attr.Value{
+				"group_uuid":    types.StringValue("group-uuid"),
+				"member_emails": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alice@example.com")}),
+			},
+		},
+		{
+			name: "empty member uuids",
+			attributes: map[string]attr.Value{
+				"group_uuid":   types.StringValue("group-uuid"),
+				"member_uuids": types.SetValueMust(types.StringType, []attr.Value{}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewGroupMembershipSyncResource()
+			state := newResourceState(t, r, tt.attributes)
+			resp := &resource.ValidateConfigResponse{}
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("ValidateConfig() diagnostics = %v, expectError %v", resp.Diagnostics, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestResolveOrganizationMemberUuids(t *testing.T) {
+	organizationMembers := []apiv1.GetOrganizationMembersV1Results{
+		{UserUUID: "user-1", Email: "alice@example.com"},
+		{UserUUID: "user-2", Email: "bob@example.com"},
+	}
+
+	resolved, unresolved := resolveOrganizationMemberUuids(organizationMembers, []string{"Alice@Example.com", "user-2", "carol@example.com"})
+	if !reflect.DeepEqual(resolved, []string{"user-1", "user-2"}) {
+		t.Errorf("resolved = %v, want [user-1 user-2]", resolved)
+	}
+	if !reflect.DeepEqual(unresolved, []string{"carol@example.com"}) {
+		t.Errorf("unresolved = %v, want [carol@example.com]", unresolved)
+	}
+}
+
+func TestDiffGroupMembership(t *testing.T) {
+	currentMembers := []string{"user-3", "user-1", "user-4"}
+	desiredMembers := []string{"user-1", "user-2", "user-5"}
+	unmanagedMembers := map[string]struct{}{"user-4": {}}
+
+	addedMembers, removedMembers := diffGroupMembership(currentMembers, desiredMembers, unmanagedMembers)
+	if !reflect.DeepEqual(addedMembers, []string{"user-2", "user-5"}) {
+		t.Errorf("addedMembers = %v, want [user-2 user-5]", addedMembers)
+	}
+	if !reflect.DeepEqual(removedMembers, []string{"user-3"}) {
+		t.Errorf("removedMembers = %v, want [user-3]", removedMembers)
+	}
+
+	addedMembers, removedMembers = diffGroupMembership(desiredMembers, desiredMembers, nil)
+	if len(addedMembers) != 0 || len(removedMembers) != 0 {
+		t.Errorf("diffGroupMembership() of the same members = %v, %v, want no changes", addedMembers, removedMembers)
+	}
+}
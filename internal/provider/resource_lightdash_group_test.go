@@ -16,10 +16,12 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
 // Using the shared testAccPreCheck and testAccProtoV6ProviderFactories from provider_acc_test.go
@@ -115,3 +117,31 @@ func TestAccGroupResource_import(t *testing.T) {
 		},
 	})
 }
+
+func TestFindGroupUuidsByName(t *testing.T) {
+	groups := []models.OrganizationGroup{
+		{GroupUUID: "group-3", Name: "analysts"},
+		{GroupUUID: "group-1", Name: "analysts"},
+		{GroupUUID: "group-2", Name: "engineers"},
+	}
+
+	tests := []struct {
+		name              string
+		excludedGroupUuid string
+		expected          []string
+	}{
+		{name: "analysts", expected: []string{"group-1", "group-3"}},
+		{name: "analysts", excludedGroupUuid: "group-1", expected: []string{"group-3"}},
+		{name: "engineers", excludedGroupUuid: "group-2", expected: []string{}},
+		{name: "Analysts", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.excludedGroupUuid, func(t *testing.T) {
+			got := findGroupUuidsByName(groups, tt.name, tt.excludedGroupUuid)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("findGroupUuidsByName(%q, %q) = %v, want %v", tt.name, tt.excludedGroupUuid, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &organizationSettingsResource{}
+	_ resource.ResourceWithConfigure   = &organizationSettingsResource{}
+	_ resource.ResourceWithImportState = &organizationSettingsResource{}
+)
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &organizationSettingsResource{}
+}
+
+// organizationSettingsResource defines the resource implementation.
+type organizationSettingsResource struct {
+	client *api.Client
+}
+
+// organizationSettingsResourceModel describes the resource data model.
+type organizationSettingsResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	OrganizationUUID   types.String `tfsdk:"organization_uuid"`
+	Name               types.String `tfsdk:"name"`
+	DefaultProjectUUID types.String `tfsdk:"default_project_uuid"`
+	ChartColors        types.List   `tfsdk:"chart_colors"`
+	ActivePaletteUUID  types.String `tfsdk:"active_palette_uuid"`
+}
+
+func (r *organizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *organizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_organization_settings.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the settings of the Lightdash organization",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash organization of the token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization. The current name is kept when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project that users land on by default. The current project is kept when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"chart_colors": schema.ListAttribute{
+				MarkdownDescription: "The default color palette of the charts, as hex colors (e.g. `#5470c6`). The current palette is kept when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active_palette_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the color palette of the organization appearance that is active for the charts. The palette must exist in the organization. The current palette is kept when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *organizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan organizationSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the settings
+	if err := r.updateSettings(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization settings",
+			"Could not update organization settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state organizationSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the organization
+	organization, err := apiv1.GetMyOrganizationV1(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading organization settings",
+			"Could not read organization settings: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(setOrganizationSettingsState(ctx, &state, organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ActivePaletteUUID = r.getActivePaletteUuid(ctx, state.ActivePaletteUUID, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan organizationSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the settings
+	if err := r.updateSettings(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization settings",
+			"Could not update organization settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The organization settings can't be deleted, so they are kept as they are.
+	// This only removes the resource from the state.
+	tflog.Info(ctx, "Removing organization settings from the state without changing them")
+}
+
+func (r *organizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The organization is determined by the token, so the ID only has to match it
+	organization, err := apiv1.GetMyOrganizationV1(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting organization",
+			"Could not get organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if req.ID != getOrganizationSettingsResourceId(organization.OrganizationUUID) {
+		resp.Diagnostics.AddError(
+			"Error importing organization settings",
+			fmt.Sprintf("The import ID must be %q for the organization of the token, got: %q", getOrganizationSettingsResourceId(organization.OrganizationUUID), req.ID),
+		)
+		return
+	}
+
+	state := organizationSettingsResourceModel{}
+	resp.Diagnostics.Append(setOrganizationSettingsState(ctx, &state, organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ActivePaletteUUID = r.getActivePaletteUuid(ctx, types.StringNull(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// updateSettings applies the configured settings and fills the computed attributes from the organization
+func (r *organizationSettingsResource) updateSettings(ctx context.Context, plan *organizationSettingsResourceModel) error {
+	request, err := getUpdateMyOrganizationRequest(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	// The palettes of the appearance are managed by their own endpoints.
+	// The active palette is checked first, so that nothing is updated when it doesn't exist.
+	palettes, err := apiv1.ListColorPalettesV1(r.client)
+	if err != nil {
+		return err
+	}
+	var activePalette *apiv1.ListColorPalettesV1Results
+	if !plan.ActivePaletteUUID.IsNull() && !plan.ActivePaletteUUID.IsUnknown() {
+		activePalette = findColorPalette(palettes, plan.ActivePaletteUUID.ValueString())
+		if activePalette == nil {
+			return fmt.Errorf("the color palette %s doesn't exist in the organization", plan.ActivePaletteUUID.ValueString())
+		}
+	}
+
+	if err := apiv1.UpdateMyOrganizationV1(r.client, request); err != nil {
+		return err
+	}
+
+	if activePalette != nil {
+		if !activePalette.IsActive {
+			tflog.Info(ctx, fmt.Sprintf("Activating color palette %s (%s)", activePalette.Name, activePalette.ColorPaletteUUID))
+			if err := apiv1.SetActiveColorPaletteV1(r.client, activePalette.ColorPaletteUUID); err != nil {
+				return err
+			}
+		}
+	} else {
+		plan.ActivePaletteUUID = types.StringPointerValue(getActiveColorPaletteUuid(palettes))
+	}
+
+	// Read the settings back to fill the settings which aren't configured
+	organization, err := apiv1.GetMyOrganizationV1(r.client)
+	if err != nil {
+		return err
+	}
+	if diags := setOrganizationSettingsState(ctx, plan, organization); diags.HasError() {
+		return fmt.Errorf("could not set chart_colors from the organization")
+	}
+	return nil
+}
+
+// getUpdateMyOrganizationRequest builds the update request from the plan.
+// The settings which are not configured are left out, so that the organization keeps them.
+func getUpdateMyOrganizationRequest(ctx context.Context, plan *organizationSettingsResourceModel) (*apiv1.UpdateMyOrganizationV1Request, error) {
+	request := &apiv1.UpdateMyOrganizationV1Request{}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		name := plan.Name.ValueString()
+		request.Name = &name
+	}
+	if !plan.DefaultProjectUUID.IsNull() && !plan.DefaultProjectUUID.IsUnknown() {
+		defaultProjectUuid := plan.DefaultProjectUUID.ValueString()
+		request.DefaultProjectUUID = &defaultProjectUuid
+	}
+	if !plan.ChartColors.IsNull() && !plan.ChartColors.IsUnknown() {
+		if diags := plan.ChartColors.ElementsAs(ctx, &request.ChartColors, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read chart_colors from the plan")
+		}
+	}
+	return request, nil
+}
+
+// getActivePaletteUuid returns the UUID of the active color palette of the organization.
+// Failing to list the palettes is not fatal, so a warning is added and the fallback value is returned.
+func (r *organizationSettingsResource) getActivePaletteUuid(ctx context.Context, fallback types.String, diagnostics *diag.Diagnostics) types.String {
+	palettes, err := apiv1.ListColorPalettesV1(r.client)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not list color palettes: %s", err.Error()))
+		diagnostics.AddWarning(
+			"Unable to read color palettes",
+			"Could not list the color palettes of the organization, active_palette_uuid is not refreshed: "+err.Error(),
+		)
+		return fallback
+	}
+	return types.StringPointerValue(getActiveColorPaletteUuid(palettes))
+}
+
+// findColorPalette returns the color palette with the UUID, or nil when it doesn't exist
+func findColorPalette(palettes []apiv1.ListColorPalettesV1Results, colorPaletteUuid string) *apiv1.ListColorPalettesV1Results {
+	for i := range palettes {
+		if palettes[i].ColorPaletteUUID == colorPaletteUuid {
+			return &palettes[i]
+		}
+	}
+	return nil
+}
+
+// getActiveColorPaletteUuid returns the UUID of the active color palette, or nil when none is active
+func getActiveColorPaletteUuid(palettes []apiv1.ListColorPalettesV1Results) *string {
+	for _, palette := range palettes {
+		if palette.IsActive {
+			return &palette.ColorPaletteUUID
+		}
+	}
+	return nil
+}
+
+func setOrganizationSettingsState(ctx context.Context, state *organizationSettingsResourceModel, organization *apiv1.GetMyOrganizationV1Results) diag.Diagnostics {
+	state.ID = types.StringValue(getOrganizationSettingsResourceId(organization.OrganizationUUID))
+	state.OrganizationUUID = types.StringValue(organization.OrganizationUUID)
+	state.Name = types.StringValue(organization.Name)
+	state.DefaultProjectUUID = types.StringPointerValue(organization.DefaultProjectUUID)
+	chartColors := organization.ChartColors
+	if chartColors == nil {
+		chartColors = []string{}
+	}
+	var diags diag.Diagnostics
+	state.ChartColors, diags = types.ListValueFrom(ctx, types.StringType, chartColors)
+	return diags
+}
+
+func getOrganizationSettingsResourceId(organizationUuid string) string {
+	return fmt.Sprintf("organizations/%s/settings", organizationUuid)
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestAccOrganizationSettingsResource_basic(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_organization_settings")
+	}
+
+	// Get the provider config
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	// No setting is configured, so the settings of the organization are only read
+	createConfig010, err := ReadAccTestResource([]string{"resources", "lightdash_organization_settings", "basic", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get createConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig010,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lightdash_organization_settings.test", "organization_uuid", "data.lightdash_organization.test", "organization_uuid"),
+					resource.TestCheckResourceAttrSet("lightdash_organization_settings.test", "name"),
+					resource.TestCheckResourceAttrSet("lightdash_organization_settings.test", "chart_colors.#"),
+				),
+			},
+			{
+				Config:            providerConfig + createConfig010,
+				ResourceName:      "lightdash_organization_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					res, ok := state.RootModule().Resources["lightdash_organization_settings.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state for import")
+					}
+					// Get the organization_uuid from the state
+					organization_uuid, ok := res.Primary.Attributes["organization_uuid"]
+					if !ok || organization_uuid == "" {
+						return "", fmt.Errorf("organization_uuid attribute not present in state")
+					}
+					// Construct the import ID in the form 'organizations/<organization_uuid>/settings'
+					return getOrganizationSettingsResourceId(organization_uuid), nil
+				},
+			},
+		},
+	})
+}
+
+func TestGetUpdateMyOrganizationRequest(t *testing.T) {
+	ctx := context.Background()
+	name := "test organization"
+	defaultProjectUuid := "project-uuid"
+
+	tests := []struct {
+		name     string
+		plan     organizationSettingsResourceModel
+		expected *apiv1.UpdateMyOrganizationV1Request
+	}{
+		{
+			name: "all settings configured",
+			plan: organizationSettingsResourceModel{
+				Name:               types.StringValue(name),
+				DefaultProjectUUID: types.StringValue(defaultProjectUuid),
+				ChartColors:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("#5470c6"), types.StringValue("#fc8452")}),
+			},
+			expected: &apiv1.UpdateMyOrganizationV1Request{
+				Name:               &name,
+				DefaultProjectUUID: &defaultProjectUuid,
+				ChartColors:        []string{"#5470c6", "#fc8452"},
+			},
+		},
+		{
+			name: "settings not configured",
+			plan: organizationSettingsResourceModel{
+				Name:               types.StringNull(),
+				DefaultProjectUUID: types.StringNull(),
+				ChartColors:        types.ListNull(types.StringType),
+			},
+			expected: &apiv1.UpdateMyOrganizationV1Request{},
+		},
+		{
+			name: "settings unknown",
+			plan: organizationSettingsResourceModel{
+				Name:               types.StringUnknown(),
+				DefaultProjectUUID: types.StringUnknown(),
+				ChartColors:        types.ListUnknown(types.StringType),
+			},
+			expected: &apiv1.UpdateMyOrganizationV1Request{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := getUpdateMyOrganizationRequest(ctx, &tt.plan)
+			if err != nil {
+				t.Fatalf("getUpdateMyOrganizationRequest() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(request, tt.expected) {
+				t.Errorf("getUpdateMyOrganizationRequest() = %+v, want %+v", request, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindColorPalette(t *testing.T) {
+	palettes := []apiv1.ListColorPalettesV1Results{
+		{ColorPaletteUUID: "default-uuid", Name: "Default"},
+		{ColorPaletteUUID: "brand-uuid", Name: "Brand", IsActive: true},
+	}
+
+	tests := []struct {
+		name             string
+		colorPaletteUuid string
+		expected         string
+	}{
+		{name: "existing palette", colorPaletteUuid: "default-uuid", expected: "Default"},
+		{name: "active palette", colorPaletteUuid: "brand-uuid", expected: "Brand"},
+		{name: "missing palette", colorPaletteUuid: "other-uuid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			palette := findColorPalette(palettes, tt.colorPaletteUuid)
+			if tt.expected == "" {
+				if palette != nil {
+					t.Errorf("findColorPalette() = %v, want nil", palette)
+				}
+				return
+			}
+			if palette == nil || palette.Name != tt.expected {
+				t.Errorf("findColorPalette() = %v, want the %s palette", palette, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetActiveColorPaletteUuid(t *testing.T) {
+	brandUuid := "brand-uuid"
+	tests := []struct {
+		name     string
+		palettes []apiv1.ListColorPalettesV1Results
+		expected *string
+	}{
+		{
+			name: "active palette",
+			palettes: []apiv1.ListColorPalettesV1Results{
+				{ColorPaletteUUID: "default-uuid"},
+				{ColorPaletteUUID: "brand-uuid", IsActive: true},
+			},
+			expected: &brandUuid,
+		},
+		{
+			name:     "no active palette",
+			palettes: []apiv1.ListColorPalettesV1Results{{ColorPaletteUUID: "default-uuid"}},
+		},
+		{
+			name: "no palette",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := getActiveColorPaletteUuid(tt.palettes); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("getActiveColorPaletteUuid() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOrganizationSettingsUpdateActivePalette(t *testing.T) {
+	tests := []struct {
+		name              string
+		activePaletteUuid types.String
+		expectedRequests  []string
+		expectedPalette   string
+		expectError       bool
+	}{
+		{
+			name:              "inactive palette",
+			activePaletteUuid: types.StringValue("default-uuid"),
+			expectedRequests:  []string{"GET /api/v1/org/color-palettes", "PATCH /api/v1/org", "POST /api/v1/org/color-palettes/default-uuid/active", "GET /api/v1/org"},
+			expectedPalette:   "default-uuid",
+		},
+		{
+			name:              "already active palette",
+			activePaletteUuid: types.StringValue("brand-uuid"),
+			expectedRequests:  []string{"GET /api/v1/org/color-palettes", "PATCH /api/v1/org", "GET /api/v1/org"},
+			expectedPalette:   "brand-uuid",
+		},
+		{
+			name:              "palette not configured",
+			activePaletteUuid: types.StringNull(),
+			expectedRequests:  []string{"GET /api/v1/org/color-palettes", "PATCH /api/v1/org", "GET /api/v1/org"},
+			expectedPalette:   "brand-uuid",
+		},
+		{
+			// Nothing is updated when the palette doesn't exist
+			name:              "missing palette",
+			activePaletteUuid: types.StringValue("other-uuid"),
+			expectedRequests:  []string{"GET /api/v1/org/color-palettes"},
+			expectError:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				switch r.URL.Path {
+				case "/api/v1/org/color-palettes":
+					_, _ = w.Write([]byte(`{"status": "ok", "results": [{"colorPaletteUuid": "default-uuid", "name": "Default", "isActive": false}, {"colorPaletteUuid": "brand-uuid", "name": "Brand", "isActive": true}]}`))
+				case "/api/v1/org":
+					_, _ = w.Write([]byte(`{"status": "ok", "results": {"organizationUuid": "organization-uuid", "name": "Organization"}}`))
+				default:
+					_, _ = w.Write([]byte(`{"status": "ok"}`))
+				}
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			r := &organizationSettingsResource{client: client}
+			plan := organizationSettingsResourceModel{
+				Name:               types.StringNull(),
+				DefaultProjectUUID: types.StringNull(),
+				ChartColors:        types.ListNull(types.StringType),
+				ActivePaletteUUID:  tt.activePaletteUuid,
+			}
+			err = r.updateSettings(context.Background(), &plan)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("updateSettings() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !reflect.DeepEqual(requests, tt.expectedRequests) {
+				t.Errorf("updateSettings() requests = %v, want %v", requests, tt.expectedRequests)
+			}
+			if !tt.expectError && plan.ActivePaletteUUID.ValueString() != tt.expectedPalette {
+				t.Errorf("active_palette_uuid = %s, want %s", plan.ActivePaletteUUID.ValueString(), tt.expectedPalette)
+			}
+		})
+	}
+}
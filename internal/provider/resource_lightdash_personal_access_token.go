@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -50,7 +51,11 @@ type personalAccessTokenResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	ExpiresAt   types.String `tfsdk:"expires_at"`
 	CreatedAt   types.String `tfsdk:"created_at"`
+	RotatedAt   types.String `tfsdk:"rotated_at"`
+	LastUsedAt  types.String `tfsdk:"last_used_at"`
 	Token       types.String `tfsdk:"token"`
+
+	RequireUniqueDescription types.Bool `tfsdk:"require_unique_description"`
 }
 
 func (r *personalAccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,6 +111,20 @@ func (r *personalAccessTokenResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the personal access token was last rotated. It is null if the token has never been rotated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the personal access token was last used. It is null if the token has never been used, and is refreshed on every read.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"token": schema.StringAttribute{
 				MarkdownDescription: "The personal access token value. This is only available after creation and cannot be retrieved later.",
 				Computed:            true,
@@ -114,6 +133,10 @@ func (r *personalAccessTokenResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"require_unique_description": schema.BoolAttribute{
+				MarkdownDescription: "If true, creation fails when the authenticated user already has a token with the same description. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -144,6 +167,28 @@ func (r *personalAccessTokenResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Make sure the description is not used by another token if requested
+	if plan.RequireUniqueDescription.ValueBool() {
+		existingTokens, err := r.client.ListPersonalAccessTokensV1(api.WithResource(ctx, "lightdash_personal_access_token", plan.Description.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing personal access tokens",
+				"Could not list personal access tokens to check the description uniqueness, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for _, token := range existingTokens {
+			if token.Description == plan.Description.ValueString() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("description"),
+					"Duplicate personal access token description",
+					fmt.Sprintf("A personal access token with description %q already exists (UUID: %s). Use a different description or set require_unique_description to false.", token.Description, token.UUID),
+				)
+				return
+			}
+		}
+	}
+
 	// Prepare the request
 	createRequest := &models.CreatePersonalAccessToken{
 		Description:   plan.Description.ValueString(),
@@ -173,6 +218,8 @@ func (r *personalAccessTokenResource) Create(ctx context.Context, req resource.C
 	plan.TokenUUID = types.StringValue(createdToken.UUID)
 	plan.Description = types.StringValue(createdToken.Description)
 	plan.CreatedAt = types.StringValue(createdToken.CreatedAt)
+	plan.RotatedAt = types.StringPointerValue(createdToken.RotatedAt)
+	plan.LastUsedAt = types.StringPointerValue(createdToken.LastUsedAt)
 	plan.Token = types.StringValue(createdToken.Token)
 
 	// Set expires_at from response
@@ -203,7 +250,7 @@ func (r *personalAccessTokenResource) Read(ctx context.Context, req resource.Rea
 	tokenUuid := state.TokenUUID.ValueString()
 
 	// List all personal access tokens to find the current one
-	tokens, err := r.client.ListPersonalAccessTokensV1()
+	tokens, err := r.client.ListPersonalAccessTokensV1(api.WithResource(ctx, "lightdash_personal_access_token", state.Description.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading personal access token",
@@ -230,6 +277,8 @@ func (r *personalAccessTokenResource) Read(ctx context.Context, req resource.Rea
 	// Update state with fetched values (keep token as-is since it's not returned by list)
 	state.Description = types.StringValue(foundToken.Description)
 	state.CreatedAt = types.StringValue(foundToken.CreatedAt)
+	state.RotatedAt = types.StringPointerValue(foundToken.RotatedAt)
+	state.LastUsedAt = types.StringPointerValue(foundToken.LastUsedAt)
 
 	if foundToken.ExpiresAt != nil {
 		state.ExpiresAt = types.StringValue(*foundToken.ExpiresAt)
@@ -247,11 +296,18 @@ func (r *personalAccessTokenResource) Read(ctx context.Context, req resource.Rea
 
 func (r *personalAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Personal access tokens cannot be updated, they must be recreated
-	// This is handled by the RequiresReplace plan modifier on the description and expires_at attributes
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Personal access tokens cannot be updated. Changes require recreation of the resource.",
-	)
+	// This is handled by the RequiresReplace plan modifier on the description and expires_at attributes,
+	// since the Lightdash API has no endpoint to update a token (see dev/docs/notes/personal_access_tokens.md).
+	// Only provider-side settings such as require_unique_description reach this point.
+	var plan personalAccessTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *personalAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -16,15 +16,30 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
 	v1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
@@ -32,8 +47,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource              = &projectResource{}
-	_ resource.ResourceWithConfigure = &projectResource{}
+	_ resource.Resource                   = &projectResource{}
+	_ resource.ResourceWithConfigure      = &projectResource{}
+	_ resource.ResourceWithImportState    = &projectResource{}
+	_ resource.ResourceWithValidateConfig = &projectResource{}
+	_ resource.ResourceWithModifyPlan     = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -50,11 +68,16 @@ type dbtConnectionModel struct {
 	Type                types.String `tfsdk:"type"`
 	AuthorizationMethod types.String `tfsdk:"authorization_method"`
 	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+	InstallationID      types.String `tfsdk:"installation_id"`
 	Repository          types.String `tfsdk:"repository"`
 	Branch              types.String `tfsdk:"branch"`
 	ProjectSubPath      types.String `tfsdk:"project_sub_path"`
 	HostDomain          types.String `tfsdk:"host_domain"`
 	Target              types.String `tfsdk:"target"`
+	Selector            types.String `tfsdk:"selector"`
+	Environment         types.Map    `tfsdk:"environment"`
+	EnvironmentID       types.String `tfsdk:"environment_id"`
+	APIKey              types.String `tfsdk:"api_key"`
 }
 
 // warehouseConnectionModel describes the warehouse connection nested object
@@ -70,6 +93,28 @@ type warehouseConnectionModel struct {
 	Priority           types.String `tfsdk:"priority"`
 	Retries            types.Int64  `tfsdk:"retries"`
 	StartOfWeek        types.Int64  `tfsdk:"start_of_week"`
+	Threads            types.Int64  `tfsdk:"threads"`
+}
+
+// defaultProjectCreateTimeout bounds the project creation, which can take minutes when content is copied
+const defaultProjectCreateTimeout = 20 * time.Minute
+
+// defaultProjectUpdateTimeout bounds the in-place updates, including the retries of conflicting updates
+const defaultProjectUpdateTimeout = 5 * time.Minute
+
+// projectCompilePollInterval is the delay between two checks of the explores of a project waiting for its first compile
+var projectCompilePollInterval = 10 * time.Second
+
+// projectTimeoutsModel describes the timeouts nested object
+type projectTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+}
+
+// contentCopySelectorModel describes the content copy selector nested object
+type contentCopySelectorModel struct {
+	SpaceUUIDs     types.Set `tfsdk:"space_uuids"`
+	DashboardUUIDs types.Set `tfsdk:"dashboard_uuids"`
 }
 
 // projectResourceModel describes the resource data model.
@@ -77,13 +122,35 @@ type projectResourceModel struct {
 	ID                                   types.String              `tfsdk:"id"`
 	OrganizationUUID                     types.String              `tfsdk:"organization_uuid"`
 	ProjectUUID                          types.String              `tfsdk:"project_uuid"`
+	ProjectURL                           types.String              `tfsdk:"project_url"`
+	ImportID                             types.String              `tfsdk:"import_id"`
+	Host                                 types.String              `tfsdk:"host"`
+	HostToken                            types.String              `tfsdk:"host_token"`
 	Name                                 types.String              `tfsdk:"name"`
 	Type                                 types.String              `tfsdk:"type"`
 	DbtVersion                           types.String              `tfsdk:"dbt_version"`
+	ResolvedDbtVersion                   types.String              `tfsdk:"resolved_dbt_version"`
 	DbtConnection                        *dbtConnectionModel       `tfsdk:"dbt_connection"`
 	OrganizationWarehouseCredentialsUUID types.String              `tfsdk:"organization_warehouse_credentials_uuid"`
+	OrganizationWarehouseCredentialsName types.String              `tfsdk:"organization_warehouse_credentials_name"`
+	OrganizationWarehouseCredentials     types.Object              `tfsdk:"organization_warehouse_credentials"`
 	WarehouseConnection                  *warehouseConnectionModel `tfsdk:"warehouse_connection"`
 	UpstreamProjectUUID                  types.String              `tfsdk:"upstream_project_uuid"`
+	CloneFromProjectUUID                 types.String              `tfsdk:"clone_from_project_uuid"`
+	LinkedUpstreamProjectUUID            types.String              `tfsdk:"linked_upstream_project_uuid"`
+	ContentCopySelector                  *contentCopySelectorModel `tfsdk:"content_copy_selector"`
+	CopyContent                          types.Bool                `tfsdk:"copy_content"`
+	CopyWarehouseConnection              types.Bool                `tfsdk:"copy_warehouse_connection"`
+	HasContentCopy                       types.Bool                `tfsdk:"has_content_copy"`
+	ExploreCount                         types.Int64               `tfsdk:"explore_count"`
+	WarehouseType                        types.String              `tfsdk:"warehouse_type"`
+	DbtType                              types.String              `tfsdk:"dbt_type"`
+	QueryRowLimit                        types.Int64               `tfsdk:"query_row_limit"`
+	Timeouts                             *projectTimeoutsModel     `tfsdk:"timeouts"`
+	RecreateOnUpdate                     types.Bool                `tfsdk:"recreate_on_update"`
+	IgnoreNameChanges                    types.Bool                `tfsdk:"ignore_name_changes"`
+	ReadRefresh                          types.Bool                `tfsdk:"read_refresh"`
+	WaitForCompile                       types.Bool                `tfsdk:"wait_for_compile"`
 }
 
 func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -92,7 +159,7 @@ func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Lightdash project with GitHub dbt connection.",
+		MarkdownDescription: "Manages a Lightdash project with a GitHub or local (no repository) dbt connection.",
 		Description:         "Manages a Lightdash project",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -116,6 +183,32 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The Lightdash host of the project, e.g. `https://lightdash.example.com`, when it differs from the provider `host`. It requires `host_token`, since the provider credentials are never sent to another host. Changing it creates the project on the new host, and the project on the previous host is left as is. To manage several projects on another host, prefer a provider alias configured for that host.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_token": schema.StringAttribute{
+				MarkdownDescription: "The personal access token used against `host`. Required when `host` is set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"project_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the project in Lightdash, i.e. `<host>/projects/<project_uuid>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_id": schema.StringAttribute{
+				MarkdownDescription: "The ID to import the project with, i.e. `organizations/<organization_uuid>/projects/<project_uuid>`. It is an alias of `id` meant to be consumed by other tooling, e.g. to import the project in another workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the project.",
 				Required:            true,
@@ -128,37 +221,48 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"dbt_version": schema.StringAttribute{
-				MarkdownDescription: "The dbt version to use (e.g., 'v1.8', 'v1.9', 'v1.10').",
+				MarkdownDescription: "The dbt version to use (e.g., 'v1.8', 'v1.9', 'v1.10'). Use 'latest' to pick the newest version supported by the server, or the newest version known to the provider when the server doesn't report its versions; the alias is resolved once when the version is set (at creation, or in the plan of an update) and is not updated afterwards. Use 'auto' to let Lightdash detect the version from the `dbt_project.yml` of the repository, which fails when the server doesn't support it. Changing the version updates the project in place.",
 				Required:            true,
 			},
+			"resolved_dbt_version": schema.StringAttribute{
+				MarkdownDescription: "The concrete dbt version used by the project. It differs from `dbt_version` only when `dbt_version` is 'latest' or 'auto'.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"dbt_connection": schema.SingleNestedAttribute{
-				MarkdownDescription: "The dbt connection configuration for GitHub.",
+				MarkdownDescription: "The dbt connection configuration.",
 				Required:            true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						MarkdownDescription: "The type of dbt connection. Currently only 'github' is supported.",
+						MarkdownDescription: "The type of dbt connection. Valid values are 'github', 'dbt_cloud_ide', 'dbt' and 'none'. With 'dbt' or 'none', the project is created without a git repository and the dbt content is deployed separately (e.g. with `lightdash deploy`). With 'dbt_cloud_ide', the project is compiled by a dbt Cloud environment.",
 						Required:            true,
 					},
 					"authorization_method": schema.StringAttribute{
-						MarkdownDescription: "The authorization method. Valid values are 'personal_access_token' or 'installation_id'.",
-						Required:            true,
+						MarkdownDescription: "The authorization method. Valid values are 'personal_access_token' or 'installation_id'. Required when type is 'github'.",
+						Optional:            true,
 					},
 					"personal_access_token": schema.StringAttribute{
 						MarkdownDescription: "The GitHub personal access token. Required when authorization_method is 'personal_access_token'.",
 						Optional:            true,
 						Sensitive:           true,
 					},
+					"installation_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the GitHub App installation. Only allowed when authorization_method is 'installation_id'. Lightdash uses the installation of the organization when it is not set.",
+						Optional:            true,
+					},
 					"repository": schema.StringAttribute{
-						MarkdownDescription: "The GitHub repository in the format 'owner/repo'.",
-						Required:            true,
+						MarkdownDescription: "The GitHub repository in the format 'owner/repo'. Required when type is 'github'.",
+						Optional:            true,
 					},
 					"branch": schema.StringAttribute{
-						MarkdownDescription: "The Git branch to use.",
-						Required:            true,
+						MarkdownDescription: "The Git branch to use. Required when type is 'github'.",
+						Optional:            true,
 					},
 					"project_sub_path": schema.StringAttribute{
-						MarkdownDescription: "The subdirectory path within the repository where the dbt project is located (e.g., '/' or '/dbt').",
-						Required:            true,
+						MarkdownDescription: "The subdirectory path within the repository where the dbt project is located (e.g., '/' or '/dbt'). Required when type is 'github'. With 'dbt' or 'none', it is the optional path of the dbt project deployed with the Lightdash CLI.",
+						Optional:            true,
 					},
 					"host_domain": schema.StringAttribute{
 						MarkdownDescription: "The GitHub host domain. Optional, for GitHub Enterprise.",
@@ -168,14 +272,65 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 						MarkdownDescription: "The dbt target to use.",
 						Optional:            true,
 					},
+					"selector": schema.StringAttribute{
+						MarkdownDescription: "The dbt selector of the models to compile, e.g. `tag:lightdash` or `marts.finance+,tag:daily`.",
+						Optional:            true,
+						Validators: []validator.String{
+							ValidateDbtSelector{},
+						},
+					},
+					"environment": schema.MapAttribute{
+						MarkdownDescription: "The environment variables of the dbt project, e.g. for the `env_var` function in `profiles.yml`. The values are sensitive: they are masked in the logs and in the errors, so they can be read from a secret manager, e.g. with a Vault data source. They are not returned by the API, so changes made in the Lightdash UI are not detected.",
+						Optional:            true,
+						Sensitive:           true,
+						ElementType:         types.StringType,
+					},
+					"environment_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the dbt Cloud environment. Required when type is 'dbt_cloud_ide'. It is read back from Lightdash, so repointing the environment in the UI shows up as drift.",
+						Optional:            true,
+					},
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "The dbt Cloud service token. Required when type is 'dbt_cloud_ide'. It is not returned by the API, so changes made in the Lightdash UI are not detected.",
+						Optional:            true,
+						Sensitive:           true,
+					},
 				},
 			},
 			"organization_warehouse_credentials_uuid": schema.StringAttribute{
-				MarkdownDescription: "The UUID of the organization warehouse credentials to use. Mutually exclusive with warehouse_connection.",
+				MarkdownDescription: "The UUID of the organization warehouse credentials to use. Mutually exclusive with organization_warehouse_credentials_name and warehouse_connection. Defaults to the provider's `default_warehouse_credentials_uuid` when none is set. It is resolved from organization_warehouse_credentials_name when the name is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_warehouse_credentials_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization warehouse credentials to use. It is resolved to organization_warehouse_credentials_uuid when the project is created, and must match exactly one set of credentials. Mutually exclusive with organization_warehouse_credentials_uuid and warehouse_connection.",
 				Optional:            true,
 			},
+			"organization_warehouse_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "The details of the organization warehouse credentials used by the project. It is null when the project uses an inline `warehouse_connection`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the organization warehouse credentials.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the organization warehouse credentials.",
+						Computed:            true,
+					},
+					"warehouse_type": schema.StringAttribute{
+						MarkdownDescription: "The type of warehouse of the organization warehouse credentials.",
+						Computed:            true,
+					},
+				},
+			},
 			"warehouse_connection": schema.SingleNestedAttribute{
-				MarkdownDescription: "The warehouse connection configuration. Mutually exclusive with organization_warehouse_credentials_uuid.",
+				MarkdownDescription: "The warehouse connection configuration. Mutually exclusive with organization_warehouse_credentials_uuid and organization_warehouse_credentials_name.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
@@ -191,7 +346,7 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Required:            true,
 					},
 					"keyfile_contents": schema.StringAttribute{
-						MarkdownDescription: "The contents of the service account key file in JSON format.",
+						MarkdownDescription: "The contents of the service account key file in JSON format. Base64-encoded contents are decoded automatically, which avoids escaping the JSON.",
 						Required:            true,
 						Sensitive:           true,
 					},
@@ -208,7 +363,7 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Optional:            true,
 					},
 					"maximum_bytes_billed": schema.Int64Attribute{
-						MarkdownDescription: "The maximum bytes that can be billed for a query.",
+						MarkdownDescription: "The maximum bytes that can be billed for a query. `0` means no limit: the field is omitted so that Lightdash applies its default.",
 						Optional:            true,
 					},
 					"priority": schema.StringAttribute{
@@ -223,12 +378,119 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 						MarkdownDescription: "The start of week (0 = Sunday, 1 = Monday, etc.).",
 						Optional:            true,
 					},
+					"threads": schema.Int64Attribute{
+						MarkdownDescription: "The number of threads used by dbt to compile the project. It must be positive. Supported by the BigQuery, Postgres, Redshift and Snowflake warehouse types.",
+						Optional:            true,
+					},
 				},
 			},
 			"upstream_project_uuid": schema.StringAttribute{
-				MarkdownDescription: "The UUID of the upstream project for PREVIEW type projects.",
+				MarkdownDescription: "The UUID of the upstream project for PREVIEW type projects. DEFAULT projects can also reference an upstream project, e.g. to promote content to it, but they don't follow it like a preview does.",
+				Optional:            true,
+			},
+			"clone_from_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project to clone into a new DEFAULT project. Lightdash copies the content of a new project from its upstream project, so the clone is created with the source project as upstream project, see `linked_upstream_project_uuid`. Unlike a PREVIEW project created with `upstream_project_uuid`, the clone keeps its own connections and is not a preview of the source project. Mutually exclusive with `upstream_project_uuid`.",
+				Optional:            true,
+			},
+			"linked_upstream_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the upstream project recorded by Lightdash. It is `upstream_project_uuid` for a project following an upstream project, and the source project for a clone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_copy_selector": schema.SingleNestedAttribute{
+				MarkdownDescription: "Selects the content copied from the upstream project when the project is created. All the content is copied when it is not set. Requires `upstream_project_uuid` or `clone_from_project_uuid`. Changing or removing it after creation requires recreating the project, see `recreate_on_update`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"space_uuids": schema.SetAttribute{
+						MarkdownDescription: "The UUIDs of the upstream spaces to copy.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"dashboard_uuids": schema.SetAttribute{
+						MarkdownDescription: "The UUIDs of the upstream dashboards to copy.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"copy_content": schema.BoolAttribute{
+				MarkdownDescription: "Whether the content of the upstream project is copied when the PREVIEW project is created. Lightdash copies it when it is not set. Requires `upstream_project_uuid`, and only applies on creation.",
+				Optional:            true,
+			},
+			"copy_warehouse_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the warehouse connection of the upstream project is copied when the PREVIEW project is created, instead of setting one on the project. Requires `upstream_project_uuid`, and only applies on creation. The provider `default_warehouse_credentials_uuid` is not used when it is `true`.",
+				Optional:            true,
+			},
+			"has_content_copy": schema.BoolAttribute{
+				MarkdownDescription: "Whether content was copied from the upstream or cloned project when the project was created. It is null for projects imported or created before the attribute existed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_row_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of rows returned by the queries of the project. It is updated in place. The limit of Lightdash is left as is when it is not set.",
+				Optional:            true,
+			},
+			"timeouts": schema.SingleNestedAttribute{
+				MarkdownDescription: "Custom timeouts for long-running operations.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for the project creation, as a duration string such as `30m` or `1h`. Defaults to `20m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							ValidateDuration{},
+						},
+					},
+					"update": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for the in-place updates of the project, as a duration string such as `10m`. Defaults to `5m`.",
+						Optional:            true,
+						Validators: []validator.String{
+							ValidateDuration{},
+						},
+					},
+				},
+			},
+			"recreate_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether changes that can't be applied in place destroy and recreate the project instead of failing the apply. Since destroying a project doesn't delete it from Lightdash, the previous project is left as is. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ignore_name_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project name is managed outside Terraform. When `true`, renames made in Lightdash are not detected as drift, and changing `name` is only recorded in the state without renaming the project. Defaults to `false`.",
+				Optional:            true,
+			},
+			"read_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Whether reading the project refreshes its attributes from Lightdash. When `false`, reading only checks that the project still exists, so a deleted project is still detected, but changes made in the UI are neither shown as drift nor reverted. The attributes are always read on import. Defaults to `true`.",
+				Optional:            true,
+			},
+			"wait_for_compile": schema.BoolAttribute{
+				MarkdownDescription: "Whether the creation waits for the first compile of the dbt project, until its explores appear in Lightdash. The creation fails when no explore compiles, or when the explores don't appear before the create timeout. It can't be set for the 'dbt' and 'none' dbt connection types, whose content is deployed separately. Only applies on creation. Defaults to `false`.",
 				Optional:            true,
 			},
+			"explore_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of explores compiled from the dbt project. A value of 0 after compilation usually means that the dbt connection (e.g. `project_sub_path`) is misconfigured.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_type": schema.StringAttribute{
+				MarkdownDescription: "The type of warehouse of the project in Lightdash, e.g. `bigquery`. A warning is raised when it no longer matches `warehouse_connection.type`, e.g. after the warehouse was changed in the UI.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dbt_type": schema.StringAttribute{
+				MarkdownDescription: "The type of dbt connection of the project in Lightdash, e.g. `github`. A warning is raised when it no longer matches `dbt_connection.type`, e.g. after the dbt connection was changed in the UI.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -249,6 +511,201 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config projectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A clone is an independent DEFAULT project, while a preview follows its upstream project
+	if !config.CloneFromProjectUUID.IsNull() {
+		if !config.UpstreamProjectUUID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_from_project_uuid"),
+				"Conflicting project source",
+				"clone_from_project_uuid and upstream_project_uuid are mutually exclusive. Use upstream_project_uuid for PREVIEW projects.",
+			)
+		}
+		if !config.Type.IsUnknown() && config.Type.ValueString() != string(models.DEFAULT_PROJECT_TYPE) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_from_project_uuid"),
+				"Unsupported project type for a clone",
+				"clone_from_project_uuid can only be set for DEFAULT projects. Use upstream_project_uuid for PREVIEW projects.",
+			)
+		}
+	}
+	// Lightdash accepts an upstream project for DEFAULT projects, but it is mostly set by mistake for a preview
+	if !config.UpstreamProjectUUID.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() == string(models.DEFAULT_PROJECT_TYPE) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("upstream_project_uuid"),
+			"Upstream project on a DEFAULT project",
+			"upstream_project_uuid is set on a DEFAULT project. The upstream project is referenced, e.g. to promote content, but the project is not a preview of it. Set type to 'PREVIEW' for a preview project, or use clone_from_project_uuid to only copy its content.",
+		)
+	}
+	validateProjectCopyFlags(&config, &resp.Diagnostics)
+	// The provider credentials are not sent to another host, so the project host comes with its own token
+	if !config.Host.IsNull() && config.HostToken.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host_token"),
+			"Missing host token",
+			"host_token must be set when host is set, since the provider credentials are never sent to another host.",
+		)
+	}
+	if !config.QueryRowLimit.IsNull() && !config.QueryRowLimit.IsUnknown() && config.QueryRowLimit.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query_row_limit"),
+			"Invalid query row limit",
+			fmt.Sprintf("query_row_limit must be a positive number of rows, got: %d.", config.QueryRowLimit.ValueInt64()),
+		)
+	}
+	// The warehouse is set by exactly one of the credentials UUID, the credentials name or the inline connection
+	warehouseSources := []string{}
+	if !config.OrganizationWarehouseCredentialsUUID.IsNull() {
+		warehouseSources = append(warehouseSources, "organization_warehouse_credentials_uuid")
+	}
+	if !config.OrganizationWarehouseCredentialsName.IsNull() {
+		warehouseSources = append(warehouseSources, "organization_warehouse_credentials_name")
+	}
+	if config.WarehouseConnection != nil {
+		warehouseSources = append(warehouseSources, "warehouse_connection")
+	}
+	if len(warehouseSources) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(warehouseSources[1]),
+			"Conflicting warehouse configuration",
+			fmt.Sprintf("Only one of organization_warehouse_credentials_uuid, organization_warehouse_credentials_name or warehouse_connection can be set, got: %s.", strings.Join(warehouseSources, ", ")),
+		)
+	}
+	if config.WarehouseConnection != nil {
+		threads := config.WarehouseConnection.Threads
+		if !threads.IsNull() && !threads.IsUnknown() && threads.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("warehouse_connection").AtName("threads"),
+				"Invalid number of threads",
+				fmt.Sprintf("threads must be a positive number, got: %d.", threads.ValueInt64()),
+			)
+		}
+	}
+	// The populated warehouse fields must belong to the warehouse type
+	if config.WarehouseConnection != nil && !config.WarehouseConnection.Type.IsUnknown() {
+		if unsupportedFields := getUnsupportedWarehouseFields(config.WarehouseConnection); len(unsupportedFields) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("warehouse_connection").AtName("type"),
+				"Warehouse connection fields don't match the type",
+				fmt.Sprintf("The fields %s are not supported by the warehouse type '%s'.", strings.Join(unsupportedFields, ", "), config.WarehouseConnection.Type.ValueString()),
+			)
+		}
+	}
+	if config.DbtConnection == nil {
+		return
+	}
+
+	// The dataset and the dbt target can legitimately differ, so a mismatch is only a warning
+	if config.WarehouseConnection != nil {
+		dataset := config.WarehouseConnection.Dataset
+		target := config.DbtConnection.Target
+		if !dataset.IsNull() && !dataset.IsUnknown() && !target.IsNull() && !target.IsUnknown() {
+			if datasetEnvironment, ok := getMismatchedEnvironment(dataset.ValueString(), target.ValueString()); ok {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("warehouse_connection").AtName("dataset"),
+					"Dataset and dbt target may not match",
+					fmt.Sprintf("The dataset '%s' looks like the '%s' environment, but the dbt target is '%s'. Please check that the dataset is the schema of the dbt target.", dataset.ValueString(), datasetEnvironment, target.ValueString()),
+				)
+			}
+		}
+	}
+
+	// Values can't be validated until they are known
+	connectionType := config.DbtConnection.Type
+	if connectionType.IsUnknown() {
+		return
+	}
+	// The dbt Cloud settings only apply to dbt Cloud connections
+	dbtCloudAttributes := map[string]types.String{
+		"environment_id": config.DbtConnection.EnvironmentID,
+		"api_key":        config.DbtConnection.APIKey,
+	}
+	switch {
+	case connectionType.ValueString() == string(models.DbtProjectTypeGithub):
+		// The repository settings are required for GitHub connections
+		addMissingDbtConnectionAttributeErrors(map[string]types.String{
+			"authorization_method": config.DbtConnection.AuthorizationMethod,
+			"repository":           config.DbtConnection.Repository,
+			"branch":               config.DbtConnection.Branch,
+			"project_sub_path":     config.DbtConnection.ProjectSubPath,
+		}, connectionType.ValueString(), &resp.Diagnostics)
+		addUnsupportedDbtConnectionAttributeErrors(dbtCloudAttributes, connectionType.ValueString(), &resp.Diagnostics)
+		validateGithubAuthorization(config.DbtConnection, &resp.Diagnostics)
+	case connectionType.ValueString() == string(models.DbtProjectTypeDbtCloudIDE):
+		// The project is compiled by dbt Cloud, so the repository settings would be ignored
+		addMissingDbtConnectionAttributeErrors(dbtCloudAttributes, connectionType.ValueString(), &resp.Diagnostics)
+		addUnsupportedDbtConnectionAttributeErrors(map[string]types.String{
+			"authorization_method":  config.DbtConnection.AuthorizationMethod,
+			"personal_access_token": config.DbtConnection.PersonalAccessToken,
+			"installation_id":       config.DbtConnection.InstallationID,
+			"repository":            config.DbtConnection.Repository,
+			"branch":                config.DbtConnection.Branch,
+			"project_sub_path":      config.DbtConnection.ProjectSubPath,
+			"host_domain":           config.DbtConnection.HostDomain,
+			"target":                config.DbtConnection.Target,
+			"selector":              config.DbtConnection.Selector,
+		}, connectionType.ValueString(), &resp.Diagnostics)
+	case isLocalDbtConnectionType(connectionType.ValueString()):
+		// The content is deployed after the creation, so the first compile can't be awaited
+		if config.WaitForCompile.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_compile"),
+				"Unsupported wait for compile",
+				fmt.Sprintf("wait_for_compile can't be true when the dbt connection type is '%s', since the dbt content is deployed separately.", connectionType.ValueString()),
+			)
+		}
+		// The project has no git repository, so the repository settings would be ignored
+		addUnsupportedDbtConnectionAttributeErrors(map[string]types.String{
+			"authorization_method":  config.DbtConnection.AuthorizationMethod,
+			"personal_access_token": config.DbtConnection.PersonalAccessToken,
+			"installation_id":       config.DbtConnection.InstallationID,
+			"repository":            config.DbtConnection.Repository,
+			"branch":                config.DbtConnection.Branch,
+			"host_domain":           config.DbtConnection.HostDomain,
+			"environment_id":        config.DbtConnection.EnvironmentID,
+			"api_key":               config.DbtConnection.APIKey,
+		}, connectionType.ValueString(), &resp.Diagnostics)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dbt_connection").AtName("type"),
+			"Unsupported dbt connection type",
+			fmt.Sprintf("The dbt connection type must be one of 'github', 'dbt_cloud_ide', 'dbt' or 'none', got: '%s'.", connectionType.ValueString()),
+		)
+	}
+}
+
+// addMissingDbtConnectionAttributeErrors adds an error for each null attribute required by the dbt connection type
+func addMissingDbtConnectionAttributeErrors(attributes map[string]types.String, connectionType string, diagnostics *diag.Diagnostics) {
+	for attribute, value := range attributes {
+		if value.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("dbt_connection").AtName(attribute),
+				"Missing dbt connection attribute",
+				fmt.Sprintf("%s is required when the dbt connection type is '%s'.", attribute, connectionType),
+			)
+		}
+	}
+}
+
+// addUnsupportedDbtConnectionAttributeErrors adds an error for each attribute set although the dbt connection type ignores it
+func addUnsupportedDbtConnectionAttributeErrors(attributes map[string]types.String, connectionType string, diagnostics *diag.Diagnostics) {
+	for attribute, value := range attributes {
+		if !value.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("dbt_connection").AtName(attribute),
+				"Unsupported dbt connection attribute",
+				fmt.Sprintf("%s can't be set when the dbt connection type is '%s'.", attribute, connectionType),
+			)
+		}
+	}
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -257,9 +714,42 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	client, err := r.getClient(plan.Host, plan.HostToken)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Lightdash API Host", err.Error())
+		return
+	}
+
 	// Build dbt connection config
 	var dbtConnection *models.DbtGithubProjectConfig
-	if plan.DbtConnection != nil {
+	if plan.DbtConnection != nil && isLocalDbtConnectionType(plan.DbtConnection.Type.ValueString()) {
+		// Projects without a git repository only need the connection type
+		dbtConnection = &models.DbtGithubProjectConfig{
+			Type: models.DbtProjectType(plan.DbtConnection.Type.ValueString()),
+		}
+
+		if !plan.DbtConnection.ProjectSubPath.IsNull() {
+			projectDir := plan.DbtConnection.ProjectSubPath.ValueString()
+			dbtConnection.ProjectDir = &projectDir
+		}
+
+		if !plan.DbtConnection.Target.IsNull() {
+			target := plan.DbtConnection.Target.ValueString()
+			dbtConnection.Target = &target
+		}
+
+		if !plan.DbtConnection.Selector.IsNull() {
+			selector := plan.DbtConnection.Selector.ValueString()
+			dbtConnection.Selector = &selector
+		}
+	} else if plan.DbtConnection != nil && plan.DbtConnection.Type.ValueString() == string(models.DbtProjectTypeDbtCloudIDE) {
+		// dbt Cloud projects are compiled by the dbt Cloud environment
+		dbtConnection = &models.DbtGithubProjectConfig{
+			Type:          models.DbtProjectTypeDbtCloudIDE,
+			EnvironmentID: plan.DbtConnection.EnvironmentID.ValueStringPointer(),
+			APIKey:        plan.DbtConnection.APIKey.ValueStringPointer(),
+		}
+	} else if plan.DbtConnection != nil {
 		dbtConnection = &models.DbtGithubProjectConfig{
 			Type:                models.DbtProjectTypeGithub,
 			AuthorizationMethod: plan.DbtConnection.AuthorizationMethod.ValueString(),
@@ -273,6 +763,11 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			dbtConnection.PersonalAccessToken = &token
 		}
 
+		if !plan.DbtConnection.InstallationID.IsNull() {
+			installationID := plan.DbtConnection.InstallationID.ValueString()
+			dbtConnection.InstallationID = &installationID
+		}
+
 		if !plan.DbtConnection.HostDomain.IsNull() {
 			domain := plan.DbtConnection.HostDomain.ValueString()
 			dbtConnection.HostDomain = &domain
@@ -282,26 +777,71 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			target := plan.DbtConnection.Target.ValueString()
 			dbtConnection.Target = &target
 		}
+
+		if !plan.DbtConnection.Selector.IsNull() {
+			selector := plan.DbtConnection.Selector.ValueString()
+			dbtConnection.Selector = &selector
+		}
+	}
+
+	// Nudge towards the GitHub App when the server has it
+	r.warnPersonalAccessTokenWithGithubApp(ctx, client, dbtConnection, &resp.Diagnostics)
+
+	// The environment variables apply to every type of dbt connection
+	if dbtConnection != nil && !plan.DbtConnection.Environment.IsNull() {
+		environment, diags := getDbtEnvironment(ctx, plan.DbtConnection.Environment)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		dbtConnection.Environment = environment
 	}
 
 	// Build create project request
+	dbtVersion := resolveDbtVersion(ctx, client, plan.DbtVersion.ValueString())
 	createReq := &models.CreateProject{
 		Name:          plan.Name.ValueString(),
 		Type:          models.ProjectType(plan.Type.ValueString()),
-		DbtVersion:    plan.DbtVersion.ValueString(),
+		DbtVersion:    dbtVersion,
 		DbtConnection: dbtConnection,
 	}
 
-	if !plan.OrganizationWarehouseCredentialsUUID.IsNull() {
+	if !plan.OrganizationWarehouseCredentialsUUID.IsNull() && !plan.OrganizationWarehouseCredentialsUUID.IsUnknown() {
 		uuid := plan.OrganizationWarehouseCredentialsUUID.ValueString()
 		createReq.OrganizationWarehouseCredentialsUUID = &uuid
+	} else if !plan.OrganizationWarehouseCredentialsName.IsNull() {
+		uuid, err := r.resolveOrganizationWarehouseCredentialsUuid(client, plan.OrganizationWarehouseCredentialsName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organization_warehouse_credentials_name"),
+				"Error resolving organization warehouse credentials",
+				err.Error(),
+			)
+			return
+		}
+		createReq.OrganizationWarehouseCredentialsUUID = &uuid
+	} else if plan.WarehouseConnection == nil && !plan.CopyWarehouseConnection.ValueBool() && client.DefaultWarehouseCredentialsUUID != "" {
+		// The default credentials would replace the connection copied from the upstream project
+		uuid := client.DefaultWarehouseCredentialsUUID
+		createReq.OrganizationWarehouseCredentialsUUID = &uuid
 	}
 
 	// Build warehouse connection config
 	if plan.WarehouseConnection != nil {
+		// Never send the fields of one warehouse type with another type
+		warehouseType := plan.WarehouseConnection.Type.ValueString()
+		if unsupportedFields := getUnsupportedWarehouseFields(plan.WarehouseConnection); len(unsupportedFields) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("warehouse_connection").AtName("type"),
+				"Warehouse connection fields don't match the type",
+				fmt.Sprintf("The fields %s are not supported by the warehouse type '%s'.", strings.Join(unsupportedFields, ", "), warehouseType),
+			)
+			return
+		}
+
 		// Parse keyfile contents JSON
-		var keyfileMap map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.WarehouseConnection.KeyfileContents.ValueString()), &keyfileMap); err != nil {
+		keyfileMap, err := parseKeyfileContents(plan.WarehouseConnection.KeyfileContents.ValueString())
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error parsing keyfile_contents",
 				"Could not parse keyfile_contents as JSON: "+err.Error(),
@@ -335,10 +875,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			warehouseConn.TimeoutSeconds = &timeout
 		}
 
-		if !plan.WarehouseConnection.MaximumBytesBilled.IsNull() {
-			maxBytes := plan.WarehouseConnection.MaximumBytesBilled.ValueInt64()
-			warehouseConn.MaximumBytesBilled = &maxBytes
-		}
+		warehouseConn.MaximumBytesBilled = getMaximumBytesBilled(plan.WarehouseConnection.MaximumBytesBilled)
 
 		if !plan.WarehouseConnection.Priority.IsNull() {
 			priority := strings.ToLower(plan.WarehouseConnection.Priority.ValueString())
@@ -355,6 +892,11 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			warehouseConn.StartOfWeek = &startOfWeek
 		}
 
+		if !plan.WarehouseConnection.Threads.IsNull() {
+			threads := int(plan.WarehouseConnection.Threads.ValueInt64())
+			warehouseConn.Threads = &threads
+		}
+
 		createReq.WarehouseConnection = warehouseConn
 	}
 
@@ -363,21 +905,100 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		createReq.UpstreamProjectUUID = &upstreamUUID
 	}
 
+	// Lightdash copies the content from the upstream project, so the source of a clone is sent as upstream project
+	if !plan.CloneFromProjectUUID.IsNull() {
+		cloneFromUUID := plan.CloneFromProjectUUID.ValueString()
+		createReq.UpstreamProjectUUID = &cloneFromUUID
+	}
+
+	// Build content copy selector
+	selector, diags := getContentCopySelector(ctx, plan.ContentCopySelector, createReq.UpstreamProjectUUID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.ContentCopySelector = selector
+	createReq.CopyContent = plan.CopyContent.ValueBoolPointer()
+	createReq.CopyWarehouseConnectionFromUpstreamProject = plan.CopyWarehouseConnection.ValueBoolPointer()
+
 	// Create project
-	createdProject, err := r.client.CreateProjectV1(createReq)
+	// The creation request is logged with the project, since several projects are often created by the same apply
+	createCtx, cancel := context.WithTimeout(api.WithResource(ctx, "lightdash_project", plan.Name.ValueString()), plan.Timeouts.createTimeout())
+	defer cancel()
+	createResults, err := client.CreateProjectV1(createCtx, createReq)
+	if isUnsupportedDbtVersionAuto(dbtVersion, err) {
+		addUnsupportedDbtVersionAutoError(&resp.Diagnostics, err)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project",
-			"Could not create project, unexpected error: "+err.Error(),
-		)
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating project", "Could not create project, unexpected error: ", err)
 		return
 	}
+	createdProject := &createResults.Project
 
-	// Set state
+	// Track the created project right away, so that a failure below leaves it in the state
+	// where it can be refreshed, imported again or destroyed, instead of orphaning it
 	organizationUUID := plan.OrganizationUUID.ValueString()
 	stateId := getProjectResourceId(organizationUUID, createdProject.ProjectUUID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), stateId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), createdProject.ProjectUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), plan.Host)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Copying content from the upstream project doesn't fail the creation, so surface it as a warning unless it was turned off
+	if createReq.UpstreamProjectUUID != nil && !plan.CopyContent.Equal(types.BoolValue(false)) && (!createResults.HasContentCopy || createResults.ContentCopyError != nil) {
+		detail := "No content was copied from the upstream project."
+		if createResults.ContentCopyError != nil {
+			detail = "Could not copy content from the upstream project: " + *createResults.ContentCopyError
+		}
+		resp.Diagnostics.AddWarning("Content not copied", detail)
+	}
+
+	// The query row limit is a setting of the created project
+	if !plan.QueryRowLimit.IsNull() {
+		if err := r.updateQueryRowLimit(ctx, client, createdProject.ProjectUUID, plan.QueryRowLimit); err != nil {
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error setting query row limit", fmt.Sprintf("Could not set the query row limit of project %s, unexpected error: ", createdProject.ProjectUUID), err)
+			return
+		}
+	}
+
+	// The project is only usable once dbt has compiled, which happens in the background after the creation
+	var compiledExplores []models.Explore
+	if plan.WaitForCompile.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Waiting for the first compile of project %s", createdProject.ProjectUUID))
+		compiledExplores, err = waitForProjectCompile(createCtx, client, createdProject.ProjectUUID, projectCompilePollInterval, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Project not compiled",
+				fmt.Sprintf("Project %s was created, but it is not usable: %s", createdProject.ProjectUUID, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Set state
 	plan.ID = types.StringValue(stateId)
+	plan.ImportID = types.StringValue(stateId)
 	plan.ProjectUUID = types.StringValue(createdProject.ProjectUUID)
+	plan.ProjectURL = types.StringValue(getProjectUrl(client.HostUrl, createdProject.ProjectUUID))
+	plan.ResolvedDbtVersion = types.StringValue(resolveCreatedDbtVersion(dbtVersion, createdProject.DbtVersion))
+	plan.HasContentCopy = types.BoolValue(createResults.HasContentCopy)
+	plan.LinkedUpstreamProjectUUID = types.StringPointerValue(createReq.UpstreamProjectUUID)
+	plan.OrganizationWarehouseCredentialsUUID = types.StringPointerValue(createReq.OrganizationWarehouseCredentialsUUID)
+	plan.OrganizationWarehouseCredentials = r.getOrganizationWarehouseCredentials(ctx, client, plan.OrganizationWarehouseCredentialsUUID, types.ObjectNull(organizationWarehouseCredentialsAttrTypes), &resp.Diagnostics)
+	if plan.WaitForCompile.ValueBool() {
+		plan.ExploreCount = types.Int64Value(int64(len(compiledExplores)))
+	} else {
+		plan.ExploreCount = r.getExploreCount(ctx, client, createdProject.ProjectUUID, types.Int64Value(0), &resp.Diagnostics)
+	}
+	plan.WarehouseType = getCreatedWarehouseType(createReq, plan.OrganizationWarehouseCredentials)
+	plan.DbtType = types.StringNull()
+	if dbtConnection != nil {
+		plan.DbtType = types.StringValue(string(dbtConnection.Type))
+	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -390,9 +1011,16 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// The requests are logged with the project, since several projects are often refreshed by the same plan
+	ctx = api.WithResource(ctx, "lightdash_project", state.Name.ValueString())
 
 	// Get project
-	project, err := v1.GetProjectV1(r.client, state.ProjectUUID.ValueString())
+	client, err := r.getClient(state.Host, state.HostToken)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Lightdash API Host", err.Error())
+		return
+	}
+	project, err := v1.GetProjectV1(ctx, client, state.ProjectUUID.ValueString())
 	if err != nil {
 		// If the project is not found (404), remove it from state
 		if strings.Contains(err.Error(), "404") {
@@ -407,27 +1035,94 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// The organization of the ID must be the one of the project, which catches IDs pasted from another instance
+	if organizationUuid := state.OrganizationUUID.ValueString(); organizationUuid != "" && organizationUuid != project.OrganizationUUID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization_uuid"),
+			"Project organization mismatch",
+			fmt.Sprintf("The project %s belongs to the organization %s, but the resource ID refers to the organization %s. Please check that the ID comes from the Lightdash instance of the provider `host`.", project.ProjectUUID, project.OrganizationUUID, organizationUuid),
+		)
+		return
+	}
+
+	// The import ID only derives from the identifiers, so it is set even when the attributes are not refreshed
+	state.ImportID = types.StringValue(getProjectResourceId(project.OrganizationUUID, project.ProjectUUID))
+
+	// The project exists, which is all that is checked when the attributes are managed by Terraform only.
+	// The option is null right after an import, so imported projects are always refreshed.
+	if !state.ReadRefresh.IsNull() && !state.ReadRefresh.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping the refresh of project %s since read_refresh is false", project.ProjectUUID))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	// Update state
-	state.Name = types.StringValue(project.ProjectName)
+	state.ProjectURL = types.StringValue(getProjectUrl(client.HostUrl, project.ProjectUUID))
+	// Renames made in Lightdash are not drift when the name is managed outside Terraform
+	if !state.IgnoreNameChanges.ValueBool() {
+		state.Name = types.StringValue(project.ProjectName)
+	}
 	state.Type = types.StringValue(project.ProjectType)
 	state.OrganizationUUID = types.StringValue(project.OrganizationUUID)
 
 	if project.DbtVersion != "" {
-		state.DbtVersion = types.StringValue(project.DbtVersion)
+		// Keep the "latest" and "auto" aliases in the state, since the version is resolved from them
+		if state.DbtVersion.ValueString() != models.DbtVersionLatest && state.DbtVersion.ValueString() != models.DbtVersionAuto {
+			state.DbtVersion = types.StringValue(project.DbtVersion)
+		}
+		state.ResolvedDbtVersion = types.StringValue(project.DbtVersion)
 	}
 
-	if project.OrganizationWarehouseCredentialsUUID != nil {
-		state.OrganizationWarehouseCredentialsUUID = types.StringValue(*project.OrganizationWarehouseCredentialsUUID)
-	} else {
+	// The query row limit of Lightdash is only tracked when it is managed
+	if !state.QueryRowLimit.IsNull() && project.QueryRowLimit != nil {
+		state.QueryRowLimit = types.Int64Value(*project.QueryRowLimit)
+	}
+
+	// The connection blocks only hold what was written, so a swap of connection kind made in the UI is reported
+	state.WarehouseType = types.StringNull()
+	if project.WarehouseConnection != nil {
+		state.WarehouseType = types.StringValue(string(project.WarehouseConnection.Type))
+	}
+	state.DbtType = types.StringNull()
+	if project.DbtConnection != nil {
+		state.DbtType = types.StringValue(project.DbtConnection.Type)
+	}
+	readDbtConnection(state.DbtConnection, project.DbtConnection)
+	addConnectionTypeDriftWarnings(&state, &resp.Diagnostics)
+
+	// Organization warehouse credentials and inline warehouse connections are mutually exclusive,
+	// so switching between them in the UI shows up as drift on both attributes.
+	usedOrganizationCredentials := !state.OrganizationWarehouseCredentialsUUID.IsNull() && !state.OrganizationWarehouseCredentialsUUID.IsUnknown()
+	if project.OrganizationWarehouseCredentialsUUID != nil {
+		state.OrganizationWarehouseCredentialsUUID = types.StringValue(*project.OrganizationWarehouseCredentialsUUID)
+		state.WarehouseConnection = nil
+	} else {
 		state.OrganizationWarehouseCredentialsUUID = types.StringNull()
+		if usedOrganizationCredentials && state.WarehouseConnection == nil && project.WarehouseConnection != nil {
+			state.WarehouseConnection = readWarehouseConnection(ctx, nil, project.WarehouseConnection, &resp.Diagnostics)
+		}
 	}
 
-	if project.UpstreamProjectUUID != nil {
+	// The source of a clone is recorded as its upstream project, which is only exposed as the linked upstream project
+	state.LinkedUpstreamProjectUUID = types.StringPointerValue(project.UpstreamProjectUUID)
+	isClone := project.UpstreamProjectUUID != nil && state.UpstreamProjectUUID.IsNull() && state.CloneFromProjectUUID.ValueString() == *project.UpstreamProjectUUID
+	if isClone {
+		state.UpstreamProjectUUID = types.StringNull()
+	} else if project.UpstreamProjectUUID != nil {
 		state.UpstreamProjectUUID = types.StringValue(*project.UpstreamProjectUUID)
 	} else {
 		state.UpstreamProjectUUID = types.StringNull()
 	}
 
+	// Refresh the non-sensitive warehouse fields so that changes made in the UI show up as drift.
+	// Secrets are never returned by the API, so they are kept from the state.
+	if state.WarehouseConnection != nil && project.WarehouseConnection != nil {
+		state.WarehouseConnection = readWarehouseConnection(ctx, state.WarehouseConnection, project.WarehouseConnection, &resp.Diagnostics)
+	}
+
+	state.OrganizationWarehouseCredentials = r.getOrganizationWarehouseCredentials(ctx, client, state.OrganizationWarehouseCredentialsUUID, state.OrganizationWarehouseCredentials, &resp.Diagnostics)
+	state.ExploreCount = r.getExploreCount(ctx, client, project.ProjectUUID, state.ExploreCount, &resp.Diagnostics)
+
 	// Note: dbt connection credentials are not returned in the API response for security reasons
 	// We keep the existing values from the state
 
@@ -436,11 +1131,166 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Projects are immutable - any change requires replacement
-	// This method exists only to satisfy the resource.Resource interface
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Lightdash projects are immutable. Any changes require destroying and recreating the resource.",
+	var plan, state projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The in-place updates may wait for a conflicting update, so they are bounded by the update timeout
+	ctx, cancel := context.WithTimeout(api.WithResource(ctx, "lightdash_project", state.Name.ValueString()), plan.Timeouts.updateTimeout())
+	defer cancel()
+
+	// Timeouts and the update options only change how Terraform behaves, so they are applied without calling Lightdash
+	state.Timeouts = plan.Timeouts
+	state.HostToken = plan.HostToken
+	state.RecreateOnUpdate = plan.RecreateOnUpdate
+	state.IgnoreNameChanges = plan.IgnoreNameChanges
+	state.ReadRefresh = plan.ReadRefresh
+	// The copy flags only apply on creation, and whether content was copied is only known then
+	state.CopyContent = plan.CopyContent
+	state.CopyWarehouseConnection = plan.CopyWarehouseConnection
+	state.WaitForCompile = plan.WaitForCompile
+	plan.HasContentCopy = state.HasContentCopy
+	plan.LinkedUpstreamProjectUUID = state.LinkedUpstreamProjectUUID
+	if plan.IgnoreNameChanges.ValueBool() {
+		state.Name = plan.Name
+	}
+
+	// Projects are immutable apart from the query row limit and the dbt version. Any other change is rejected
+	// before these are updated, so that a rejected update doesn't leave the project partially updated.
+	applied := state
+	applied.QueryRowLimit = plan.QueryRowLimit
+	applied.DbtVersion = plan.DbtVersion
+	applied.ResolvedDbtVersion = plan.ResolvedDbtVersion
+	if !reflect.DeepEqual(applied, plan) && !isImportedProjectAdoption(&applied, &plan) {
+		resp.Diagnostics.AddError(
+			"Update not supported",
+			"Lightdash projects are immutable. Any changes require destroying and recreating the resource. Set recreate_on_update to true to recreate the project automatically.",
+		)
+		return
+	}
+
+	// The query row limit is a project setting, so it is updated in place
+	if !plan.QueryRowLimit.IsNull() && !plan.QueryRowLimit.Equal(state.QueryRowLimit) {
+		client, err := r.getClient(state.Host, plan.HostToken)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Lightdash API Host", err.Error())
+			return
+		}
+		if err := r.updateQueryRowLimit(ctx, client, state.ProjectUUID.ValueString(), plan.QueryRowLimit); err != nil {
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating query row limit", fmt.Sprintf("Could not update the query row limit of project %s, unexpected error: ", state.ProjectUUID.ValueString()), err)
+			return
+		}
+	}
+	state.QueryRowLimit = plan.QueryRowLimit
+
+	// The dbt version is updated in place, so bumping dbt keeps the content of the project
+	if !plan.DbtVersion.Equal(state.DbtVersion) {
+		client, err := r.getClient(state.Host, plan.HostToken)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Lightdash API Host", err.Error())
+			return
+		}
+		// The "latest" alias is resolved against the server in the plan
+		dbtVersion := plan.DbtVersion.ValueString()
+		if dbtVersion == models.DbtVersionLatest && !plan.ResolvedDbtVersion.IsUnknown() {
+			dbtVersion = plan.ResolvedDbtVersion.ValueString()
+		}
+		dbtVersion = resolveDbtVersion(ctx, client, dbtVersion)
+		err = r.updateDbtVersion(ctx, client, state.ProjectUUID.ValueString(), dbtVersion)
+		if isUnsupportedDbtVersionAuto(dbtVersion, err) {
+			addUnsupportedDbtVersionAutoError(&resp.Diagnostics, err)
+			return
+		}
+		if err != nil {
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating dbt version", fmt.Sprintf("Could not update the dbt version of project %s, unexpected error: ", state.ProjectUUID.ValueString()), err)
+			return
+		}
+		state.DbtVersion = plan.DbtVersion
+		state.ResolvedDbtVersion = plan.ResolvedDbtVersion
+
+		// The version detected by the server is only known once the project is read back
+		if plan.ResolvedDbtVersion.IsUnknown() {
+			project, err := v1.GetProjectV1(ctx, client, state.ProjectUUID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading project",
+					fmt.Sprintf("Could not read the dbt version of project %s: %s", state.ProjectUUID.ValueString(), err.Error()),
+				)
+				return
+			}
+			state.ResolvedDbtVersion = types.StringValue(resolveCreatedDbtVersion(dbtVersion, project.DbtVersion))
+			plan.ResolvedDbtVersion = state.ResolvedDbtVersion
+		}
+	}
+
+	// The connection blocks are not returned by the API, so they are missing from the state right after an import.
+	// Adopting them from the configuration doesn't change anything in Lightdash.
+	if isImportedProjectAdoption(&state, &plan) {
+		tflog.Info(ctx, fmt.Sprintf("Adopting connection settings from the configuration for imported project %s", state.ProjectUUID.ValueString()))
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is replaced when the project is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new dbt version is validated and resolved in the plan, since it is updated in place
+	if !plan.DbtVersion.IsUnknown() && !plan.DbtVersion.Equal(state.DbtVersion) {
+		if err := validateDbtVersion(plan.DbtVersion.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("dbt_version"), "Unsupported dbt version", err.Error())
+			return
+		}
+		resolvedDbtVersion := types.StringValue(models.ResolveDbtVersion(plan.DbtVersion.ValueString()))
+		if plan.DbtVersion.ValueString() == models.DbtVersionLatest && r.client != nil {
+			client, err := r.getClient(state.Host, state.HostToken)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Lightdash API Host", err.Error())
+				return
+			}
+			resolvedDbtVersion = types.StringValue(resolveDbtVersion(ctx, client, plan.DbtVersion.ValueString()))
+		}
+		// The version detected by the server is only known after the update
+		if plan.DbtVersion.ValueString() == models.DbtVersionAuto {
+			resolvedDbtVersion = types.StringUnknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_dbt_version"), resolvedDbtVersion)...)
+	}
+
+	if !plan.RecreateOnUpdate.ValueBool() {
+		// The connection can't be switched in place, so it is reported in the plan rather than on every apply
+		if isUnusedWarehouseConnection(&state, &plan) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("warehouse_connection"),
+				"Warehouse connection not used by the project",
+				fmt.Sprintf("The project %s uses the organization warehouse credentials %s, so warehouse_connection can't be applied in place. Remove warehouse_connection to keep the organization warehouse credentials, or set recreate_on_update to true to recreate the project with the warehouse connection.", state.ProjectUUID.ValueString(), state.OrganizationWarehouseCredentialsUUID.ValueString()),
+			)
+		}
+		return
+	}
+
+	// Changes that would fail in Update recreate the project instead
+	paths := getRecreatedProjectAttributes(&state, &plan)
+	if len(paths) == 0 {
+		return
+	}
+	resp.RequiresReplace.Append(paths...)
+	resp.Diagnostics.AddWarning(
+		"Project will be recreated",
+		"recreate_on_update is enabled, so the project is recreated to apply the changes. The previous project is not deleted from Lightdash and must be deleted manually if desired.",
 	)
 }
 
@@ -454,6 +1304,839 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	)
 }
 
+// getClient returns the client targeting the host of the project, which defaults to the provider host.
+// Another host is authenticated with the token of the project, so that the provider credentials never leave the provider host.
+func (r *projectResource) getClient(host types.String, token types.String) (*api.Client, error) {
+	if host.IsNull() || host.IsUnknown() {
+		return r.client, nil
+	}
+	hostUrl, err := normalizeHostUrl(host.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("please set the `host` attribute to an http(s) URL such as `https://app.lightdash.cloud`: %w", err)
+	}
+	if token.IsNull() || token.IsUnknown() || token.ValueString() == "" {
+		return nil, fmt.Errorf("please set the `host_token` attribute to a personal access token of %s", hostUrl)
+	}
+	return r.client.WithHost(hostUrl, token.ValueString()), nil
+}
+
+// warnPersonalAccessTokenWithGithubApp adds a warning when the server has the GitHub App, which is preferred over personal access tokens.
+// The server is only checked for GitHub connections using a personal access token, so other creations don't pay for the request.
+// Failing to check the server is not worth failing the creation, so it is only logged.
+func (r *projectResource) warnPersonalAccessTokenWithGithubApp(ctx context.Context, client *api.Client, dbtConnection *models.DbtGithubProjectConfig, diagnostics *diag.Diagnostics) {
+	if dbtConnection == nil || dbtConnection.Type != models.DbtProjectTypeGithub || dbtConnection.AuthorizationMethod != "personal_access_token" {
+		return
+	}
+	health, err := v1.GetHealthV1(client)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Could not check whether the GitHub App is available: %s", err.Error()))
+		return
+	}
+	if !health.HasGithub {
+		return
+	}
+	diagnostics.AddAttributeWarning(
+		path.Root("dbt_connection").AtName("authorization_method"),
+		"GitHub App available",
+		"The Lightdash server has the GitHub App installed, which is preferred over a personal access token: it isn't tied to a user and doesn't expire. Consider setting authorization_method to 'installation_id'.",
+	)
+}
+
+// resolveDbtVersion resolves the "latest" alias to the newest dbt version supported by the server.
+// The versions known to the provider are used when the server doesn't report its versions or can't be reached.
+func resolveDbtVersion(ctx context.Context, client *api.Client, dbtVersion string) string {
+	if dbtVersion != models.DbtVersionLatest {
+		return dbtVersion
+	}
+	health, err := v1.GetHealthV1(client)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not get the dbt versions supported by the server, so 'latest' is resolved with the versions known to the provider: %s", err.Error()))
+		return models.ResolveDbtVersion(dbtVersion)
+	}
+	if len(health.SupportedDbtVersions) == 0 {
+		tflog.Debug(ctx, "The server doesn't report its supported dbt versions, so 'latest' is resolved with the versions known to the provider")
+	}
+	return models.ResolveDbtVersionFrom(dbtVersion, health.SupportedDbtVersions)
+}
+
+// updateQueryRowLimit sets the maximum number of rows returned by the queries of the project
+func (r *projectResource) updateQueryRowLimit(ctx context.Context, client *api.Client, projectUuid string, queryRowLimit types.Int64) error {
+	tflog.Info(ctx, fmt.Sprintf("Setting the query row limit of project %s to %d", projectUuid, queryRowLimit.ValueInt64()))
+	return v1.UpdateProjectSettingsV1(client, projectUuid, v1.UpdateProjectSettingsV1Request{
+		QueryRowLimit: queryRowLimit.ValueInt64Pointer(),
+	})
+}
+
+// updateDbtVersion switches the dbt version of the project, keeping its name and connections
+func (r *projectResource) updateDbtVersion(ctx context.Context, client *api.Client, projectUuid string, dbtVersion string) error {
+	tflog.Info(ctx, fmt.Sprintf("Setting the dbt version of project %s to %s", projectUuid, dbtVersion))
+	return v1.UpdateProjectV1(ctx, client, projectUuid, func(current *v1.GetProjectV1Results) (*models.UpdateProject, error) {
+		return &models.UpdateProject{
+			Name:                                 current.ProjectName,
+			DbtVersion:                           dbtVersion,
+			OrganizationWarehouseCredentialsUUID: current.OrganizationWarehouseCredentialsUUID,
+		}, nil
+	})
+}
+
+// resolveCreatedDbtVersion returns the dbt version used by the project.
+// The version detected by the server replaces "auto", unless the server doesn't return it.
+func resolveCreatedDbtVersion(requestedDbtVersion string, projectDbtVersion string) string {
+	if requestedDbtVersion == models.DbtVersionAuto && projectDbtVersion != "" {
+		return projectDbtVersion
+	}
+	return requestedDbtVersion
+}
+
+// isUnsupportedDbtVersionAuto reports whether the server rejected the "auto" dbt version, which older servers don't support.
+// Only the validation errors about the dbt version match, so that the other rejected fields are reported as they are.
+func isUnsupportedDbtVersionAuto(dbtVersion string, err error) bool {
+	if err == nil || dbtVersion != models.DbtVersionAuto {
+		return false
+	}
+	statusCode, _ := api.StatusCodeOf(err)
+	if statusCode != http.StatusBadRequest && statusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+
+	// The rejected fields are named by most validation errors
+	if validationErrors := api.ValidationErrorsOf(err); len(validationErrors) > 0 {
+		for _, validationError := range validationErrors {
+			if validationError.Field == "dbtVersion" || strings.HasSuffix(validationError.Field, ".dbtVersion") {
+				return true
+			}
+		}
+		return false
+	}
+	var statusError *api.StatusError
+	if !errors.As(err, &statusError) {
+		return false
+	}
+	body := strings.ToLower(string(statusError.Body))
+	return strings.Contains(body, "dbtversion") || strings.Contains(body, "dbt version")
+}
+
+func addUnsupportedDbtVersionAutoError(diagnostics *diag.Diagnostics, err error) {
+	diagnostics.AddAttributeError(
+		path.Root("dbt_version"),
+		"dbt version detection not supported",
+		fmt.Sprintf("The Lightdash server rejected dbt_version 'auto', so it can't detect the dbt version from the repository. Set dbt_version to a version such as '%s', or to 'latest'. Server error: %s", models.SupportedDbtVersions[len(models.SupportedDbtVersions)-1], err.Error()),
+	)
+}
+
+// getExploreCount returns the number of compiled explores of the project.
+// Failing to list the explores is not fatal, so a warning is added and the fallback value is returned.
+func (r *projectResource) getExploreCount(ctx context.Context, client *api.Client, projectUuid string, fallback types.Int64, diagnostics *diag.Diagnostics) types.Int64 {
+	explores, err := client.ListExploresV1(ctx, projectUuid)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not list explores of project %s: %s", projectUuid, err.Error()))
+		diagnostics.AddWarning(
+			"Unable to count explores",
+			fmt.Sprintf("Could not list the explores of project %s, explore_count is not refreshed: %s", projectUuid, err.Error()),
+		)
+		if fallback.IsUnknown() {
+			return types.Int64Null()
+		}
+		return fallback
+	}
+	return types.Int64Value(int64(len(explores)))
+}
+
+// waitForProjectCompile polls the explores of the project until some appear, or until the context is done.
+// The wait stops as soon as the explores are compiled, and fails when all of them failed to compile.
+// Listing the explores may fail until the first compile, so only the errors that waiting can't fix stop it earlier.
+// Lightdash doesn't return the compile job of a created project, so a compile failing before producing any explore
+// is only reported on timeout.
+func waitForProjectCompile(ctx context.Context, client *api.Client, projectUuid string, interval time.Duration, diagnostics *diag.Diagnostics) ([]models.Explore, error) {
+	var lastErr error
+	for {
+		explores, err := client.ListExploresV1(ctx, projectUuid)
+		if err == nil && len(explores) > 0 {
+			return explores, checkCompiledExplores(explores, diagnostics)
+		}
+		switch statusCode, _ := api.StatusCodeOf(err); statusCode {
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+			return nil, fmt.Errorf("could not list the explores of the project: %w", err)
+		}
+		lastErr = err
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			if lastErr != nil {
+				return nil, fmt.Errorf("no explore was compiled before the create timeout, last error: %w", lastErr)
+			}
+			return nil, fmt.Errorf("no explore was compiled before the create timeout")
+		}
+	}
+}
+
+// checkCompiledExplores fails when none of the explores compiled, and adds a warning for the explores that failed to compile
+func checkCompiledExplores(explores []models.Explore, diagnostics *diag.Diagnostics) error {
+	failed := []string{}
+	for _, explore := range explores {
+		if len(explore.Errors) > 0 {
+			failed = append(failed, fmt.Sprintf("%s: %s", explore.Name, explore.Errors[0].Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	if len(failed) == len(explores) {
+		return fmt.Errorf("the compile failed for all the %d explores:\n%s", len(explores), strings.Join(failed, "\n"))
+	}
+	diagnostics.AddWarning(
+		"Explores not compiled",
+		fmt.Sprintf("%d of the %d explores failed to compile:\n%s", len(failed), len(explores), strings.Join(failed, "\n")),
+	)
+	return nil
+}
+
+var organizationWarehouseCredentialsAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"description":    types.StringType,
+	"warehouse_type": types.StringType,
+}
+
+// getCreatedWarehouseType returns the warehouse type of a created project, from its inline warehouse connection
+// or from its organization warehouse credentials. It is null when the type is unknown until the next read.
+func getCreatedWarehouseType(createReq *models.CreateProject, organizationWarehouseCredentials types.Object) types.String {
+	if createReq.WarehouseConnection != nil {
+		return types.StringValue(strings.ToLower(createReq.WarehouseConnection.Type))
+	}
+	if organizationWarehouseCredentials.IsNull() || organizationWarehouseCredentials.IsUnknown() {
+		return types.StringNull()
+	}
+	if warehouseType, ok := organizationWarehouseCredentials.Attributes()["warehouse_type"].(types.String); ok && !warehouseType.IsUnknown() {
+		return warehouseType
+	}
+	return types.StringNull()
+}
+
+// addConnectionTypeDriftWarnings warns when the connection types of the project in Lightdash
+// no longer match the types of the connection blocks, e.g. after a warehouse swap in the UI.
+func addConnectionTypeDriftWarnings(state *projectResourceModel, diagnostics *diag.Diagnostics) {
+	if state.WarehouseConnection != nil && !state.WarehouseType.IsNull() && !strings.EqualFold(state.WarehouseConnection.Type.ValueString(), state.WarehouseType.ValueString()) {
+		diagnostics.AddAttributeWarning(
+			path.Root("warehouse_connection").AtName("type"),
+			"Warehouse type changed outside Terraform",
+			fmt.Sprintf("The project uses the '%s' warehouse type in Lightdash, but warehouse_connection.type is '%s'. The warehouse connection was probably changed in the UI.", state.WarehouseType.ValueString(), state.WarehouseConnection.Type.ValueString()),
+		)
+	}
+	if state.DbtConnection != nil && !state.DbtType.IsNull() && !strings.EqualFold(state.DbtConnection.Type.ValueString(), state.DbtType.ValueString()) {
+		diagnostics.AddAttributeWarning(
+			path.Root("dbt_connection").AtName("type"),
+			"dbt connection type changed outside Terraform",
+			fmt.Sprintf("The project uses the '%s' dbt connection type in Lightdash, but dbt_connection.type is '%s'. The dbt connection was probably changed in the UI.", state.DbtType.ValueString(), state.DbtConnection.Type.ValueString()),
+		)
+	}
+}
+
+// resolveOrganizationWarehouseCredentialsUuid returns the UUID of the only organization warehouse credentials with the name
+func (r *projectResource) resolveOrganizationWarehouseCredentialsUuid(client *api.Client, name string) (string, error) {
+	credentials, err := v1.ListOrganizationWarehouseCredentialsV1(client)
+	if err != nil {
+		return "", fmt.Errorf("could not list the organization warehouse credentials: %w", err)
+	}
+	credentialsUuids := findOrganizationWarehouseCredentialsUuidsByName(credentials, name)
+	switch len(credentialsUuids) {
+	case 0:
+		return "", fmt.Errorf("no organization warehouse credentials named '%s' were found", name)
+	case 1:
+		return credentialsUuids[0], nil
+	default:
+		return "", fmt.Errorf("%d organization warehouse credentials are named '%s' (UUIDs: %s). Please rename the credentials or use organization_warehouse_credentials_uuid", len(credentialsUuids), name, strings.Join(credentialsUuids, ", "))
+	}
+}
+
+// findOrganizationWarehouseCredentialsUuidsByName returns the sorted UUIDs of the credentials with the exact name
+func findOrganizationWarehouseCredentialsUuidsByName(credentials []v1.ListOrganizationWarehouseCredentialsV1Results, name string) []string {
+	credentialsUuids := []string{}
+	for _, credential := range credentials {
+		if credential.Name == name {
+			credentialsUuids = append(credentialsUuids, credential.OrganizationWarehouseCredentialsUUID)
+		}
+	}
+	sort.Strings(credentialsUuids)
+	return credentialsUuids
+}
+
+// getOrganizationWarehouseCredentials looks up the details of the organization warehouse credentials used by the project.
+// It returns null when the project doesn't use organization warehouse credentials.
+// Failing to look them up is not fatal, so a warning is added and the fallback value is returned.
+func (r *projectResource) getOrganizationWarehouseCredentials(ctx context.Context, client *api.Client, credentialsUuid types.String, fallback types.Object, diagnostics *diag.Diagnostics) types.Object {
+	if credentialsUuid.IsNull() || credentialsUuid.IsUnknown() {
+		return types.ObjectNull(organizationWarehouseCredentialsAttrTypes)
+	}
+	if fallback.IsUnknown() {
+		fallback = types.ObjectNull(organizationWarehouseCredentialsAttrTypes)
+	}
+
+	credentials, err := v1.ListOrganizationWarehouseCredentialsV1(client)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not list organization warehouse credentials: %s", err.Error()))
+		diagnostics.AddWarning(
+			"Unable to look up organization warehouse credentials",
+			fmt.Sprintf("Could not list the organization warehouse credentials, organization_warehouse_credentials is not refreshed: %s", err.Error()),
+		)
+		return fallback
+	}
+	for _, credential := range credentials {
+		if credential.OrganizationWarehouseCredentialsUUID != credentialsUuid.ValueString() {
+			continue
+		}
+		value, diags := types.ObjectValue(organizationWarehouseCredentialsAttrTypes, map[string]attr.Value{
+			"name":           types.StringValue(credential.Name),
+			"description":    types.StringPointerValue(credential.Description),
+			"warehouse_type": types.StringValue(credential.WarehouseType),
+		})
+		diagnostics.Append(diags...)
+		return value
+	}
+
+	diagnostics.AddWarning(
+		"Organization warehouse credentials not found",
+		fmt.Sprintf("The organization warehouse credentials %s used by the project were not found in the organization.", credentialsUuid.ValueString()),
+	)
+	return types.ObjectNull(organizationWarehouseCredentialsAttrTypes)
+}
+
+// warehouseFieldTypes lists the warehouse types supporting each field of the warehouse connection
+var warehouseFieldTypes = map[string][]models.WarehouseType{
+	"project":              {models.WarehouseTypeBigQuery},
+	"dataset":              {models.WarehouseTypeBigQuery},
+	"keyfile_contents":     {models.WarehouseTypeBigQuery},
+	"authentication_type":  {models.WarehouseTypeBigQuery},
+	"location":             {models.WarehouseTypeBigQuery},
+	"timeout_seconds":      {models.WarehouseTypeBigQuery},
+	"maximum_bytes_billed": {models.WarehouseTypeBigQuery},
+	"priority":             {models.WarehouseTypeBigQuery},
+	"retries":              {models.WarehouseTypeBigQuery},
+	"start_of_week":        models.SupportedWarehouseTypes,
+	"threads":              {models.WarehouseTypeBigQuery, models.WarehouseTypePostgres, models.WarehouseTypeRedshift, models.WarehouseTypeSnowflake},
+}
+
+// getUnsupportedWarehouseFields returns the sorted names of the populated fields that the warehouse type doesn't support.
+// Unknown fields are skipped until their value is known.
+func getUnsupportedWarehouseFields(connection *warehouseConnectionModel) []string {
+	populated := map[string]bool{
+		"project":              !connection.Project.IsNull() && !connection.Project.IsUnknown(),
+		"dataset":              !connection.Dataset.IsNull() && !connection.Dataset.IsUnknown(),
+		"keyfile_contents":     !connection.KeyfileContents.IsNull() && !connection.KeyfileContents.IsUnknown(),
+		"authentication_type":  !connection.AuthenticationType.IsNull() && !connection.AuthenticationType.IsUnknown(),
+		"location":             !connection.Location.IsNull() && !connection.Location.IsUnknown(),
+		"timeout_seconds":      !connection.TimeoutSeconds.IsNull() && !connection.TimeoutSeconds.IsUnknown(),
+		"maximum_bytes_billed": !connection.MaximumBytesBilled.IsNull() && !connection.MaximumBytesBilled.IsUnknown(),
+		"priority":             !connection.Priority.IsNull() && !connection.Priority.IsUnknown(),
+		"retries":              !connection.Retries.IsNull() && !connection.Retries.IsUnknown(),
+		"start_of_week":        !connection.StartOfWeek.IsNull() && !connection.StartOfWeek.IsUnknown(),
+		"threads":              !connection.Threads.IsNull() && !connection.Threads.IsUnknown(),
+	}
+
+	warehouseType := models.WarehouseType(strings.ToLower(connection.Type.ValueString()))
+	unsupportedFields := []string{}
+	for field, isPopulated := range populated {
+		if isPopulated && !slices.Contains(warehouseFieldTypes[field], warehouseType) {
+			unsupportedFields = append(unsupportedFields, field)
+		}
+	}
+	sort.Strings(unsupportedFields)
+	return unsupportedFields
+}
+
+// warehouseConnectionMapper maps the non-sensitive fields of a warehouse type returned by the API into the model.
+// Secrets are never returned by the API, so they must be left untouched.
+type warehouseConnectionMapper interface {
+	// newModel builds the warehouse connection from the API, with null secrets
+	newModel(remote *models.WarehouseConnection) (*warehouseConnectionModel, error)
+	// refresh updates the non-sensitive fields of the warehouse connection in place
+	refresh(current *warehouseConnectionModel, remote *models.WarehouseConnection) error
+}
+
+// warehouseConnectionMappers lists the mappers of the warehouse types supported by warehouse_connection.
+// warehouse_connection can only create BigQuery connections, so the other types have no attributes to map into yet.
+// A mapper is added here together with the attributes of its warehouse type.
+var warehouseConnectionMappers = map[models.WarehouseType]warehouseConnectionMapper{
+	models.WarehouseTypeBigQuery: bigQueryConnectionMapper{},
+}
+
+// readWarehouseConnection maps the warehouse connection returned by the API into the model, using the mapper of its warehouse type.
+// A connection whose type changed is rebuilt from the API, while the same type keeps its secrets.
+// Warehouse types without mapper are not refreshed, so a warning is added and the current value is returned.
+func readWarehouseConnection(ctx context.Context, current *warehouseConnectionModel, remote *models.WarehouseConnection, diagnostics *diag.Diagnostics) *warehouseConnectionModel {
+	mapper, ok := warehouseConnectionMappers[remote.Type]
+	if !ok {
+		tflog.Warn(ctx, fmt.Sprintf("Warehouse type %s is not supported, warehouse_connection is not refreshed", remote.Type))
+		diagnostics.AddWarning(
+			"Unsupported warehouse type",
+			fmt.Sprintf("The project uses the '%s' warehouse type, but warehouse_connection only supports 'bigquery'. warehouse_connection is not refreshed.", remote.Type),
+		)
+		return current
+	}
+
+	var err error
+	if current == nil || !strings.EqualFold(current.Type.ValueString(), string(remote.Type)) {
+		var model *warehouseConnectionModel
+		model, err = mapper.newModel(remote)
+		if err == nil {
+			return model
+		}
+	} else {
+		err = mapper.refresh(current, remote)
+	}
+	if err != nil {
+		diagnostics.AddWarning(
+			"Unable to read warehouse connection",
+			fmt.Sprintf("Could not read the warehouse connection, warehouse_connection is not refreshed: %s", err.Error()),
+		)
+	}
+	return current
+}
+
+// bigQueryConnectionMapper maps BigQuery warehouse connections. keyfile_contents is the only secret.
+type bigQueryConnectionMapper struct{}
+
+func (bigQueryConnectionMapper) newModel(remote *models.WarehouseConnection) (*warehouseConnectionModel, error) {
+	credentials := &models.BigQueryCredentials{}
+	if err := remote.Decode(credentials); err != nil {
+		return nil, err
+	}
+	return newWarehouseConnectionModel(credentials), nil
+}
+
+func (bigQueryConnectionMapper) refresh(current *warehouseConnectionModel, remote *models.WarehouseConnection) error {
+	credentials := &models.BigQueryCredentials{}
+	if err := remote.Decode(credentials); err != nil {
+		return err
+	}
+	refreshWarehouseConnection(current, credentials)
+	return nil
+}
+
+// newWarehouseConnectionModel builds the warehouse connection from the API.
+// The keyfile is never returned by the API, so it is left null.
+func newWarehouseConnectionModel(remote *models.BigQueryCredentials) *warehouseConnectionModel {
+	return &warehouseConnectionModel{
+		Type:               types.StringValue(remote.Type),
+		Project:            types.StringValue(remote.Project),
+		Dataset:            types.StringPointerValue(remote.Dataset),
+		KeyfileContents:    types.StringNull(),
+		AuthenticationType: types.StringPointerValue(remote.AuthenticationType),
+		Location:           types.StringPointerValue(remote.Location),
+		TimeoutSeconds:     types.Int64PointerValue(intToInt64Ptr(remote.TimeoutSeconds)),
+		MaximumBytesBilled: types.Int64PointerValue(remote.MaximumBytesBilled),
+		Priority:           types.StringPointerValue(remote.Priority),
+		Retries:            types.Int64PointerValue(intToInt64Ptr(remote.Retries)),
+		StartOfWeek:        types.Int64PointerValue(intToInt64Ptr(remote.StartOfWeek)),
+		Threads:            types.Int64PointerValue(intToInt64Ptr(remote.Threads)),
+	}
+}
+
+// refreshWarehouseConnection maps the non-sensitive warehouse fields returned by the API back into the model.
+// Optional attributes that are not set in the state are left untouched so server-side defaults don't cause diffs.
+func refreshWarehouseConnection(current *warehouseConnectionModel, remote *models.BigQueryCredentials) {
+	// The type is matched case-insensitively, like in readWarehouseConnection
+	if remote.Type != "" && !strings.EqualFold(current.Type.ValueString(), remote.Type) {
+		current.Type = types.StringValue(remote.Type)
+	}
+	if remote.Project != "" {
+		current.Project = types.StringValue(remote.Project)
+	}
+	current.Dataset = refreshOptionalString(current.Dataset, remote.Dataset)
+	current.AuthenticationType = refreshOptionalString(current.AuthenticationType, remote.AuthenticationType)
+	current.Location = refreshOptionalString(current.Location, remote.Location)
+	current.Priority = refreshOptionalString(current.Priority, remote.Priority)
+	current.TimeoutSeconds = refreshOptionalInt64(current.TimeoutSeconds, intToInt64Ptr(remote.TimeoutSeconds))
+	// 0 is sent as an omitted field, so a missing remote value matches it
+	if current.MaximumBytesBilled.ValueInt64() != 0 || remote.MaximumBytesBilled != nil {
+		current.MaximumBytesBilled = refreshOptionalInt64(current.MaximumBytesBilled, remote.MaximumBytesBilled)
+	}
+	current.Retries = refreshOptionalInt64(current.Retries, intToInt64Ptr(remote.Retries))
+	current.StartOfWeek = refreshOptionalInt64(current.StartOfWeek, intToInt64Ptr(remote.StartOfWeek))
+	current.Threads = refreshOptionalInt64(current.Threads, intToInt64Ptr(remote.Threads))
+}
+
+// parseKeyfileContents parses the JSON contents of a service account key file.
+// Contents which are not JSON but decode from base64 to JSON are accepted too.
+func parseKeyfileContents(contents string) (map[string]interface{}, error) {
+	var keyfileMap map[string]interface{}
+	err := json.Unmarshal([]byte(contents), &keyfileMap)
+	if err == nil {
+		return keyfileMap, nil
+	}
+
+	decoded, decodeErr := base64.StdEncoding.DecodeString(strings.TrimSpace(contents))
+	if decodeErr != nil {
+		return nil, err
+	}
+	var decodedKeyfileMap map[string]interface{}
+	if decodedErr := json.Unmarshal(decoded, &decodedKeyfileMap); decodedErr != nil {
+		return nil, fmt.Errorf("the contents are neither JSON nor base64-encoded JSON: %w", decodedErr)
+	}
+	return decodedKeyfileMap, nil
+}
+
+// getMaximumBytesBilled returns the maximum bytes billed to send to Lightdash.
+// 0 means no limit, so the field is omitted and Lightdash applies its default.
+func getMaximumBytesBilled(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() || value.ValueInt64() == 0 {
+		return nil
+	}
+	maxBytes := value.ValueInt64()
+	return &maxBytes
+}
+
+// getDbtEnvironment converts the environment variables of the dbt connection to the API model, sorted by key
+func getDbtEnvironment(ctx context.Context, environment types.Map) ([]models.DbtProjectEnvironmentVariable, diag.Diagnostics) {
+	values := map[string]string{}
+	diags := environment.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	variables := make([]models.DbtProjectEnvironmentVariable, 0, len(keys))
+	for _, key := range keys {
+		variables = append(variables, models.DbtProjectEnvironmentVariable{Key: key, Value: values[key]})
+	}
+	return variables, diags
+}
+
+// refreshOptionalString returns the remote value for a managed optional attribute.
+// The comparison is case-insensitive because some values (e.g. priority) are normalized before being sent.
+func refreshOptionalString(current types.String, remote *string) types.String {
+	if current.IsNull() || current.IsUnknown() {
+		return current
+	}
+	if remote == nil {
+		return types.StringNull()
+	}
+	if strings.EqualFold(current.ValueString(), *remote) {
+		return current
+	}
+	return types.StringValue(*remote)
+}
+
+// refreshOptionalInt64 returns the remote value for a managed optional attribute.
+func refreshOptionalInt64(current types.Int64, remote *int64) types.Int64 {
+	if current.IsNull() || current.IsUnknown() {
+		return current
+	}
+	if remote == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*remote)
+}
+
+func intToInt64Ptr(value *int) *int64 {
+	if value == nil {
+		return nil
+	}
+	converted := int64(*value)
+	return &converted
+}
+
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Extract the resource ID
+	extractedStrings, err := extractProjectResourceId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error extracting resource ID",
+			"Could not extract resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	organizationUuid := extractedStrings[0]
+	projectUuid := extractedStrings[1]
+
+	// Set the identifiers. Read populates the remaining attributes from the API.
+	// Sensitive and connection attributes stay null because the API doesn't return them.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), getProjectResourceId(organizationUuid, projectUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
+}
+
+// isImportedProjectAdoption reports whether the only differences between the state and the plan are
+// connection blocks that are missing from the state, which is the case right after an import.
+func isImportedProjectAdoption(state *projectResourceModel, plan *projectResourceModel) bool {
+	adopted := *state
+	if adopted.DbtConnection == nil {
+		adopted.DbtConnection = plan.DbtConnection
+	}
+	if adopted.WarehouseConnection == nil && !isUnusedWarehouseConnection(state, plan) {
+		adopted.WarehouseConnection = plan.WarehouseConnection
+	}
+	// The credentials name is only used to resolve the credentials UUID, which is read back
+	if adopted.OrganizationWarehouseCredentialsName.IsNull() {
+		adopted.OrganizationWarehouseCredentialsName = plan.OrganizationWarehouseCredentialsName
+	}
+	// The content copy selector only applies on creation, so it is never read back either
+	if adopted.ContentCopySelector == nil {
+		adopted.ContentCopySelector = plan.ContentCopySelector
+	}
+	if reflect.DeepEqual(adopted, *state) {
+		return false
+	}
+	return reflect.DeepEqual(adopted, *plan)
+}
+
+// isUnusedWarehouseConnection reports whether the plan sets a warehouse connection that the project doesn't use,
+// since it uses organization warehouse credentials. Read drops the connection in that case, so adopting it
+// from the configuration would show the same difference on every plan without changing anything in Lightdash.
+func isUnusedWarehouseConnection(state *projectResourceModel, plan *projectResourceModel) bool {
+	usesOrganizationCredentials := !state.OrganizationWarehouseCredentialsUUID.IsNull() && !state.OrganizationWarehouseCredentialsUUID.IsUnknown()
+	return usesOrganizationCredentials && state.WarehouseConnection == nil && plan.WarehouseConnection != nil
+}
+
+// getRecreatedProjectAttributes returns the attributes whose changes can't be applied in place.
+// The connection blocks missing from the state are adopted from the plan, so they don't count as changes.
+func getRecreatedProjectAttributes(state *projectResourceModel, plan *projectResourceModel) path.Paths {
+	var paths path.Paths
+	if !state.Name.Equal(plan.Name) && !plan.IgnoreNameChanges.ValueBool() {
+		paths = append(paths, path.Root("name"))
+	}
+	if state.DbtConnection != nil && !reflect.DeepEqual(state.DbtConnection, plan.DbtConnection) {
+		paths = append(paths, path.Root("dbt_connection"))
+	}
+	if !state.OrganizationWarehouseCredentialsUUID.Equal(plan.OrganizationWarehouseCredentialsUUID) {
+		paths = append(paths, path.Root("organization_warehouse_credentials_uuid"))
+	}
+	if !state.OrganizationWarehouseCredentialsName.IsNull() && !state.OrganizationWarehouseCredentialsName.Equal(plan.OrganizationWarehouseCredentialsName) {
+		paths = append(paths, path.Root("organization_warehouse_credentials_name"))
+	}
+	if (state.WarehouseConnection != nil && !reflect.DeepEqual(state.WarehouseConnection, plan.WarehouseConnection)) || isUnusedWarehouseConnection(state, plan) {
+		paths = append(paths, path.Root("warehouse_connection"))
+	}
+	if !state.UpstreamProjectUUID.Equal(plan.UpstreamProjectUUID) {
+		paths = append(paths, path.Root("upstream_project_uuid"))
+	}
+	if !state.CloneFromProjectUUID.Equal(plan.CloneFromProjectUUID) {
+		paths = append(paths, path.Root("clone_from_project_uuid"))
+	}
+	// The selector only applies on creation, so changing or removing it means creating the project again
+	if state.ContentCopySelector != nil && !reflect.DeepEqual(state.ContentCopySelector, plan.ContentCopySelector) {
+		paths = append(paths, path.Root("content_copy_selector"))
+	}
+	return paths
+}
+
+// getContentCopySelector converts the content copy selector to the API model.
+// The selector is only valid when content is copied from an upstream or cloned project.
+func getContentCopySelector(ctx context.Context, selector *contentCopySelectorModel, upstreamProjectUUID *string) (*models.ContentCopySelector, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if selector == nil {
+		return nil, diags
+	}
+	if upstreamProjectUUID == nil {
+		diags.AddAttributeError(
+			path.Root("content_copy_selector"),
+			"Missing upstream project",
+			"content_copy_selector can only be set together with upstream_project_uuid or clone_from_project_uuid.",
+		)
+		return nil, diags
+	}
+
+	contentCopySelector := &models.ContentCopySelector{}
+	diags.Append(selector.SpaceUUIDs.ElementsAs(ctx, &contentCopySelector.SpaceUUIDs, false)...)
+	diags.Append(selector.DashboardUUIDs.ElementsAs(ctx, &contentCopySelector.DashboardUUIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return contentCopySelector, diags
+}
+
+// validateProjectCopyFlags checks that the copy flags are only set for PREVIEW projects with an upstream project
+func validateProjectCopyFlags(config *projectResourceModel, diagnostics *diag.Diagnostics) {
+	copyFlags := map[string]types.Bool{
+		"copy_content":              config.CopyContent,
+		"copy_warehouse_connection": config.CopyWarehouseConnection,
+	}
+	for _, name := range []string{"copy_content", "copy_warehouse_connection"} {
+		if copyFlags[name].IsNull() {
+			continue
+		}
+		if !config.Type.IsUnknown() && config.Type.ValueString() != string(models.PREVIEW_PROJECT_TYPE) {
+			diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unsupported project type for copy flags",
+				fmt.Sprintf("%s can only be set for PREVIEW projects.", name),
+			)
+		}
+		if config.UpstreamProjectUUID.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing upstream project",
+				fmt.Sprintf("%s can only be set together with upstream_project_uuid.", name),
+			)
+		}
+	}
+	// Selecting content to copy contradicts turning the copy off
+	if config.ContentCopySelector != nil && config.CopyContent.Equal(types.BoolValue(false)) {
+		diagnostics.AddAttributeError(
+			path.Root("content_copy_selector"),
+			"Conflicting content copy settings",
+			"content_copy_selector can't be set when copy_content is false.",
+		)
+	}
+	// The warehouse connection is either copied from the upstream project or set on the project
+	if config.CopyWarehouseConnection.ValueBool() && (!config.OrganizationWarehouseCredentialsUUID.IsNull() || !config.OrganizationWarehouseCredentialsName.IsNull() || config.WarehouseConnection != nil) {
+		diagnostics.AddAttributeError(
+			path.Root("copy_warehouse_connection"),
+			"Conflicting warehouse settings",
+			"copy_warehouse_connection can't be true when the warehouse is set with organization_warehouse_credentials_uuid, organization_warehouse_credentials_name or warehouse_connection.",
+		)
+	}
+}
+
+// validateGithubAuthorization checks that only the credential matching the authorization method is set
+func validateGithubAuthorization(dbtConnection *dbtConnectionModel, diagnostics *diag.Diagnostics) {
+	authorizationMethod := dbtConnection.AuthorizationMethod
+	if authorizationMethod.IsNull() || authorizationMethod.IsUnknown() {
+		return
+	}
+
+	// Setting both credentials is ambiguous, typically a leftover when migrating the authorization method
+	if !dbtConnection.PersonalAccessToken.IsNull() && !dbtConnection.InstallationID.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("dbt_connection").AtName("installation_id"),
+			"Conflicting dbt connection credentials",
+			"personal_access_token and installation_id are mutually exclusive. Only set the one matching authorization_method.",
+		)
+		return
+	}
+
+	switch authorizationMethod.ValueString() {
+	case "personal_access_token":
+		if dbtConnection.PersonalAccessToken.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("dbt_connection").AtName("personal_access_token"),
+				"Missing personal access token",
+				"personal_access_token is required when authorization_method is 'personal_access_token'.",
+			)
+		}
+		if !dbtConnection.InstallationID.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("dbt_connection").AtName("installation_id"),
+				"Unsupported dbt connection attribute",
+				"installation_id can't be set when authorization_method is 'personal_access_token'.",
+			)
+		}
+	case "installation_id":
+		if !dbtConnection.PersonalAccessToken.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("dbt_connection").AtName("personal_access_token"),
+				"Unsupported dbt connection attribute",
+				"personal_access_token can't be set when authorization_method is 'installation_id'.",
+			)
+		}
+	default:
+		diagnostics.AddAttributeError(
+			path.Root("dbt_connection").AtName("authorization_method"),
+			"Unsupported authorization method",
+			fmt.Sprintf("authorization_method must be 'personal_access_token' or 'installation_id', got: '%s'.", authorizationMethod.ValueString()),
+		)
+	}
+}
+
+// createTimeout returns the configured create timeout, or the default one when it isn't set
+func (t *projectTimeoutsModel) createTimeout() time.Duration {
+	if t == nil {
+		return defaultProjectCreateTimeout
+	}
+	return parseTimeout(t.Create, defaultProjectCreateTimeout)
+}
+
+// updateTimeout returns the configured update timeout, or the default one when it isn't set
+func (t *projectTimeoutsModel) updateTimeout() time.Duration {
+	if t == nil {
+		return defaultProjectUpdateTimeout
+	}
+	return parseTimeout(t.Update, defaultProjectUpdateTimeout)
+}
+
+// parseTimeout parses a timeout validated by ValidateDuration in the plan
+func parseTimeout(value types.String, defaultTimeout time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultTimeout
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		return defaultTimeout
+	}
+	return timeout
+}
+
+// environmentNames maps the usual names of the dbt targets and the schemas to their environment
+var environmentNames = map[string]string{
+	"dev":         "dev",
+	"development": "dev",
+	"stg":         "staging",
+	"staging":     "staging",
+	"prod":        "prod",
+	"production":  "prod",
+	"test":        "test",
+	"qa":          "test",
+}
+
+// getMismatchedEnvironment returns the environment implied by the dataset when it differs from the one of the dbt target.
+// Datasets and targets that don't name a known environment are never reported.
+func getMismatchedEnvironment(dataset string, target string) (string, bool) {
+	targetEnvironment, ok := environmentNames[strings.ToLower(target)]
+	if !ok {
+		return "", false
+	}
+	datasetEnvironment := ""
+	for _, word := range strings.FieldsFunc(strings.ToLower(dataset), func(r rune) bool { return r == '_' || r == '-' }) {
+		if environment, ok := environmentNames[word]; ok {
+			if environment == targetEnvironment {
+				return "", false
+			}
+			datasetEnvironment = environment
+		}
+	}
+	return datasetEnvironment, datasetEnvironment != ""
+}
+
+// readDbtConnection refreshes the non-sensitive fields of the dbt connection returned by the API, so that changes made in the UI show up as drift.
+// The secrets, e.g. the api_key of dbt Cloud, are never returned by the API, so they are kept from the state.
+func readDbtConnection(state *dbtConnectionModel, remote *v1.GetProjectV1DbtConnection) {
+	if state == nil || remote == nil || remote.Type != state.Type.ValueString() {
+		return
+	}
+	if remote.Type == string(models.DbtProjectTypeDbtCloudIDE) && remote.EnvironmentID != nil {
+		state.EnvironmentID = types.StringValue(*remote.EnvironmentID)
+	}
+}
+
+// isLocalDbtConnectionType returns whether the dbt connection type has no git repository
+func isLocalDbtConnectionType(connectionType string) bool {
+	return connectionType == string(models.DbtProjectTypeDbt) || connectionType == string(models.DbtProjectTypeNone)
+}
+
+func getProjectUrl(hostUrl string, projectUUID string) string {
+	return fmt.Sprintf("%s/projects/%s", hostUrl, projectUUID)
+}
+
 func getProjectResourceId(organizationUUID string, projectUUID string) string {
 	return fmt.Sprintf("organizations/%s/projects/%s", organizationUUID, projectUUID)
 }
+
+func extractProjectResourceId(input string) ([]string, error) {
+	// Extract the captured groups
+	pattern := `^organizations/([^/]+)/projects/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+
+	// Return the captured strings
+	organizationUuid := groups[0]
+	projectUuid := groups[1]
+	return []string{organizationUuid, projectUuid}, nil
+}
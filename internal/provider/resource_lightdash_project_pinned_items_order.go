@@ -0,0 +1,326 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &projectPinnedItemsOrderResource{}
+	_ resource.ResourceWithConfigure   = &projectPinnedItemsOrderResource{}
+	_ resource.ResourceWithImportState = &projectPinnedItemsOrderResource{}
+)
+
+func NewProjectPinnedItemsOrderResource() resource.Resource {
+	return &projectPinnedItemsOrderResource{}
+}
+
+// projectPinnedItemsOrderResource defines the resource implementation.
+type projectPinnedItemsOrderResource struct {
+	client *api.Client
+}
+
+// projectPinnedItemsOrderResourceModel describes the resource data model.
+type projectPinnedItemsOrderResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	ItemUUIDs   types.List   `tfsdk:"item_uuids"`
+}
+
+func (r *projectPinnedItemsOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_pinned_items_order"
+}
+
+func (r *projectPinnedItemsOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_project_pinned_items_order.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the order of the items pinned to the homepage of a Lightdash project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/pinned_items_order`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_uuids": schema.ListAttribute{
+				MarkdownDescription: "The UUIDs of the pinned charts, dashboards and spaces, in the order they are displayed on the homepage. Every listed item must already be pinned.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *projectPinnedItemsOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *projectPinnedItemsOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectPinnedItemsOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reorder the pinned items
+	if err := r.reorderPinnedItems(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reordering pinned items",
+			fmt.Sprintf("Could not reorder the pinned items of project %s, unexpected error: %s", plan.ProjectUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getProjectPinnedItemsOrderResourceId(plan.ProjectUUID.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPinnedItemsOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectPinnedItemsOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the pinned items in their current order
+	items, err := r.listPinnedItems(ctx, state.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading pinned items order",
+			"Could not read pinned items order ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Store the whole current order, so reordering in the UI shows up as an exact order diff
+	itemUuids, diags := types.ListValueFrom(ctx, types.StringType, getPinnedItemUuids(items))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ItemUUIDs = itemUuids
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPinnedItemsOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan projectPinnedItemsOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reorder the pinned items
+	if err := r.reorderPinnedItems(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reordering pinned items",
+			fmt.Sprintf("Could not reorder the pinned items of project %s, unexpected error: %s", plan.ProjectUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectPinnedItemsOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Pinned items always have an order, so the current order is kept.
+	// This only removes the resource from the state.
+	tflog.Info(ctx, "Removing pinned items order from the state without changing the order")
+}
+
+func (r *projectPinnedItemsOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Extract the resource ID
+	projectUuid, err := extractProjectPinnedItemsOrderResourceId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error extracting resource ID",
+			"Could not extract resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set the resource attributes. The order is populated by Read.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
+}
+
+// getPinnedListUuid returns the UUID of the pinned list of the project
+func (r *projectPinnedItemsOrderResource) getPinnedListUuid(ctx context.Context, projectUuid string) (string, error) {
+	project, err := apiv1.GetProjectV1(ctx, r.client, projectUuid)
+	if err != nil {
+		return "", err
+	}
+	if project.PinnedListUUID == nil || *project.PinnedListUUID == "" {
+		return "", fmt.Errorf("project %s has no pinned items", projectUuid)
+	}
+	return *project.PinnedListUUID, nil
+}
+
+// listPinnedItems returns the pinned items of the project sorted by their order
+func (r *projectPinnedItemsOrderResource) listPinnedItems(ctx context.Context, projectUuid string) ([]apiv1.PinnedItemV1, error) {
+	pinnedListUuid, err := r.getPinnedListUuid(ctx, projectUuid)
+	if err != nil {
+		return nil, err
+	}
+	items, err := apiv1.ListPinnedItemsV1(r.client, projectUuid, pinnedListUuid)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Data.PinnedListOrder < items[j].Data.PinnedListOrder
+	})
+	return items, nil
+}
+
+// reorderPinnedItems applies the order of the plan to the pinned items
+func (r *projectPinnedItemsOrderResource) reorderPinnedItems(ctx context.Context, plan *projectPinnedItemsOrderResourceModel) error {
+	var itemUuids []string
+	if diags := plan.ItemUUIDs.ElementsAs(ctx, &itemUuids, false); diags.HasError() {
+		return fmt.Errorf("could not read item_uuids from the plan")
+	}
+
+	projectUuid := plan.ProjectUUID.ValueString()
+	pinnedListUuid, err := r.getPinnedListUuid(ctx, projectUuid)
+	if err != nil {
+		return err
+	}
+	items, err := apiv1.ListPinnedItemsV1(r.client, projectUuid, pinnedListUuid)
+	if err != nil {
+		return err
+	}
+
+	ordered, err := orderPinnedItems(items, itemUuids)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, fmt.Sprintf("Reordering %d pinned items of project %s", len(ordered), projectUuid))
+	return apiv1.UpdatePinnedItemsOrderV1(r.client, projectUuid, pinnedListUuid, ordered)
+}
+
+// orderPinnedItems sorts the pinned items by the given UUIDs and renumbers their order.
+// All the pinned items must be listed exactly once, so that the state matches what Read returns.
+func orderPinnedItems(items []apiv1.PinnedItemV1, itemUuids []string) ([]apiv1.PinnedItemV1, error) {
+	pinned := map[string]apiv1.PinnedItemV1{}
+	for _, item := range items {
+		pinned[item.Data.UUID] = item
+	}
+
+	ordered := make([]apiv1.PinnedItemV1, 0, len(items))
+	listed := map[string]bool{}
+	for _, uuid := range itemUuids {
+		item, ok := pinned[uuid]
+		if !ok {
+			return nil, fmt.Errorf("item %s is not pinned to the project homepage", uuid)
+		}
+		if listed[uuid] {
+			return nil, fmt.Errorf("item %s is listed more than once", uuid)
+		}
+		listed[uuid] = true
+		item.Data.PinnedListOrder = len(ordered)
+		ordered = append(ordered, item)
+	}
+
+	unlisted := []string{}
+	for _, item := range items {
+		if !listed[item.Data.UUID] {
+			unlisted = append(unlisted, item.Data.UUID)
+		}
+	}
+	if len(unlisted) > 0 {
+		sort.Strings(unlisted)
+		return nil, fmt.Errorf("pinned items %s are not listed in item_uuids", strings.Join(unlisted, ", "))
+	}
+	return ordered, nil
+}
+
+func getPinnedItemUuids(items []apiv1.PinnedItemV1) []string {
+	uuids := make([]string, 0, len(items))
+	for _, item := range items {
+		uuids = append(uuids, item.Data.UUID)
+	}
+	return uuids
+}
+
+func getProjectPinnedItemsOrderResourceId(projectUuid string) string {
+	return fmt.Sprintf("projects/%s/pinned_items_order", projectUuid)
+}
+
+func extractProjectPinnedItemsOrderResourceId(input string) (string, error) {
+	// Extract the captured groups
+	pattern := `^projects/([^/]+)/pinned_items_order$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return "", fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return groups[0], nil
+}
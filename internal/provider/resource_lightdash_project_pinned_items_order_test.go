@@ -0,0 +1,138 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestAccProjectPinnedItemsOrderResource_import(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_project_pinned_items_order")
+	}
+
+	// Get the provider config
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+	projectUuid, err := getLightdashProjectUuid()
+	if err != nil {
+		t.Fatalf("Failed to get the project UUID: %v", err)
+	}
+
+	// Items can't be pinned with the provider, so the order of the items pinned to the test project is imported
+	importConfig010, err := ReadAccTestResource([]string{"resources", "lightdash_project_pinned_items_order", "import", "010_import.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get importConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        providerConfig + importConfig010,
+				ResourceName:  "lightdash_project_pinned_items_order.test",
+				ImportState:   true,
+				ImportStateId: getProjectPinnedItemsOrderResourceId(*projectUuid),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					if states[0].Attributes["project_uuid"] != *projectUuid {
+						return fmt.Errorf("expected project_uuid %s, got %s", *projectUuid, states[0].Attributes["project_uuid"])
+					}
+					if _, ok := states[0].Attributes["item_uuids.#"]; !ok {
+						return fmt.Errorf("item_uuids attribute not present in the imported state")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestOrderPinnedItems(t *testing.T) {
+	items := []apiv1.PinnedItemV1{
+		{Type: "chart", Data: apiv1.PinnedItemV1Data{UUID: "chart-uuid", PinnedListOrder: 0}},
+		{Type: "dashboard", Data: apiv1.PinnedItemV1Data{UUID: "dashboard-uuid", PinnedListOrder: 1}},
+		{Type: "space", Data: apiv1.PinnedItemV1Data{UUID: "space-uuid", PinnedListOrder: 2}},
+	}
+
+	tests := []struct {
+		name          string
+		itemUuids     []string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:      "reordered",
+			itemUuids: []string{"space-uuid", "chart-uuid", "dashboard-uuid"},
+			expected:  []string{"space-uuid", "chart-uuid", "dashboard-uuid"},
+		},
+		{
+			name:          "unknown item",
+			itemUuids:     []string{"space-uuid", "chart-uuid", "dashboard-uuid", "other-uuid"},
+			expectedError: "item other-uuid is not pinned",
+		},
+		{
+			name:          "missing items",
+			itemUuids:     []string{"dashboard-uuid"},
+			expectedError: "pinned items chart-uuid, space-uuid are not listed",
+		},
+		{
+			name:          "duplicate item",
+			itemUuids:     []string{"chart-uuid", "chart-uuid", "dashboard-uuid", "space-uuid"},
+			expectedError: "item chart-uuid is listed more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := orderPinnedItems(items, tt.itemUuids)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("orderPinnedItems() error = %v, want an error containing %q", err, tt.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("orderPinnedItems() unexpected error: %v", err)
+			}
+			if uuids := getPinnedItemUuids(ordered); !reflect.DeepEqual(uuids, tt.expected) {
+				t.Errorf("orderPinnedItems() = %v, want %v", uuids, tt.expected)
+			}
+			// The order is renumbered from the position in item_uuids
+			for position, item := range ordered {
+				if item.Data.PinnedListOrder != position {
+					t.Errorf("orderPinnedItems() order of %s = %d, want %d", item.Data.UUID, item.Data.PinnedListOrder, position)
+				}
+			}
+		})
+	}
+
+	// The pinned items given to the function are left untouched
+	if items[2].Data.PinnedListOrder != 2 {
+		t.Errorf("orderPinnedItems() modified the pinned items: %v", items)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &projectRefreshScheduleResource{}
+	_ resource.ResourceWithConfigure   = &projectRefreshScheduleResource{}
+	_ resource.ResourceWithImportState = &projectRefreshScheduleResource{}
+)
+
+func NewProjectRefreshScheduleResource() resource.Resource {
+	return &projectRefreshScheduleResource{}
+}
+
+// projectRefreshScheduleResource defines the resource implementation.
+type projectRefreshScheduleResource struct {
+	client *api.Client
+}
+
+// projectRefreshScheduleResourceModel describes the resource data model.
+type projectRefreshScheduleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	Cron        types.String `tfsdk:"cron"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *projectRefreshScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_refresh_schedule"
+}
+
+func (r *projectRefreshScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_project_refresh_schedule.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the scheduled refresh of the dbt project of a Lightdash project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/refresh_schedule`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron": schema.StringAttribute{
+				MarkdownDescription: "The 5-field cron expression of the refresh, e.g. `0 6 * * *`. It is evaluated in the scheduler timezone of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateCronExpression{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the scheduled refresh runs. Disabling it keeps the schedule, e.g. to pause refreshes during a migration. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *projectRefreshScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *projectRefreshScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectRefreshScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Schedule the refresh
+	if err := r.updateRefreshSchedule(ctx, &plan); err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error creating refresh schedule", fmt.Sprintf("Could not schedule the refresh of project %s, unexpected error: ", plan.ProjectUUID.ValueString()), err)
+		return
+	}
+
+	plan.ID = types.StringValue(getProjectRefreshScheduleResourceId(plan.ProjectUUID.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRefreshScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectRefreshScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the refresh schedule
+	scheduler, err := apiv1.GetRefreshSchedulerV1(r.client, state.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading refresh schedule",
+			"Could not read refresh schedule ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The schedule was removed outside of Terraform
+	if scheduler == nil {
+		tflog.Warn(ctx, fmt.Sprintf("The refresh schedule of project %s was not found, removing it from the state", state.ProjectUUID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Cron = types.StringValue(scheduler.Cron)
+	state.Enabled = types.BoolValue(scheduler.Enabled)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRefreshScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan projectRefreshScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reschedule the refresh
+	if err := r.updateRefreshSchedule(ctx, &plan); err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating refresh schedule", fmt.Sprintf("Could not update the refresh schedule of project %s, unexpected error: ", plan.ProjectUUID.ValueString()), err)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectRefreshScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state projectRefreshScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Stop refreshing the project
+	tflog.Info(ctx, fmt.Sprintf("Removing the refresh schedule of project %s", state.ProjectUUID.ValueString()))
+	if err := apiv1.DeleteRefreshSchedulerV1(r.client, state.ProjectUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting refresh schedule",
+			fmt.Sprintf("Could not remove the refresh schedule of project %s, unexpected error: %s", state.ProjectUUID.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *projectRefreshScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Extract the resource ID
+	projectUuid, err := extractProjectRefreshScheduleResourceId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error extracting resource ID",
+			"Could not extract resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set the resource attributes. The schedule is populated by Read.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
+}
+
+// updateRefreshSchedule schedules the refresh of the project according to the plan
+func (r *projectRefreshScheduleResource) updateRefreshSchedule(ctx context.Context, plan *projectRefreshScheduleResourceModel) error {
+	projectUuid := plan.ProjectUUID.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("Scheduling the refresh of project %s with cron '%s' (enabled: %t)", projectUuid, plan.Cron.ValueString(), plan.Enabled.ValueBool()))
+	return apiv1.UpdateRefreshSchedulerV1(r.client, projectUuid, &models.ProjectRefreshScheduler{
+		Cron:    plan.Cron.ValueString(),
+		Enabled: plan.Enabled.ValueBool(),
+	})
+}
+
+func getProjectRefreshScheduleResourceId(projectUuid string) string {
+	return fmt.Sprintf("projects/%s/refresh_schedule", projectUuid)
+}
+
+func extractProjectRefreshScheduleResourceId(input string) (string, error) {
+	// Extract the captured groups
+	pattern := `^projects/([^/]+)/refresh_schedule$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return "", fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return groups[0], nil
+}
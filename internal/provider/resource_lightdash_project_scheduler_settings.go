@@ -21,10 +21,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
 )
@@ -34,6 +37,7 @@ var (
 	_ resource.Resource                = &projectSchedulerSettingsResource{}
 	_ resource.ResourceWithConfigure   = &projectSchedulerSettingsResource{}
 	_ resource.ResourceWithImportState = &projectSchedulerSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &projectSchedulerSettingsResource{}
 )
 
 func NewProjectSchedulerSettingsResource() resource.Resource {
@@ -51,6 +55,7 @@ type projectSchedulerSettingsResourceModel struct {
 	OrganizationUUID  types.String `tfsdk:"organization_uuid"`
 	ProjectUUID       types.String `tfsdk:"project_uuid"`
 	SchedulerTimezone types.String `tfsdk:"scheduler_timezone"`
+	SchedulerEnabled  types.Bool   `tfsdk:"scheduler_enabled"`
 }
 
 func (r *projectSchedulerSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,9 +91,17 @@ func (r *projectSchedulerSettingsResource) Schema(ctx context.Context, req resou
 				Required:            true,
 			},
 			"scheduler_timezone": schema.StringAttribute{
-				MarkdownDescription: "The timezone setting for the project's scheduler.",
+				MarkdownDescription: "The timezone setting for the project's scheduler, e.g. `Europe/Paris`. It is validated against the timezones supported by the Lightdash instance when it exposes them, and against the IANA timezone names otherwise.",
 				Required:            true,
 			},
+			"scheduler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the scheduled deliveries of the project are enabled. The current value is kept when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -110,6 +123,44 @@ func (r *projectSchedulerSettingsResource) Configure(ctx context.Context, req re
 	r.client = client
 }
 
+func (r *projectSchedulerSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is validated when the settings are destroyed, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var schedulerTimezone types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scheduler_timezone"), &schedulerTimezone)...)
+	if resp.Diagnostics.HasError() || schedulerTimezone.IsNull() || schedulerTimezone.IsUnknown() {
+		return
+	}
+
+	// The timezone of the state was already validated, which saves a request on every plan
+	if !req.State.Raw.IsNull() {
+		var stateTimezone types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scheduler_timezone"), &stateTimezone)...)
+		if resp.Diagnostics.HasError() || stateTimezone.Equal(schedulerTimezone) {
+			return
+		}
+	}
+
+	// The supported timezones are optional, so the validation falls back to the IANA timezone names
+	var supportedTimezones []string
+	health, err := apiv1.GetHealthV1(r.client)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Could not get the supported timezones of the Lightdash instance, validating against the IANA timezone names: %s", err.Error()))
+	} else {
+		supportedTimezones = health.SupportedTimezones
+	}
+	if err := validateTimezone(schedulerTimezone.ValueString(), supportedTimezones); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scheduler_timezone"),
+			"Invalid scheduler timezone",
+			err.Error()+".",
+		)
+	}
+}
+
 func (r *projectSchedulerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var plan projectSchedulerSettingsResourceModel
@@ -131,7 +182,10 @@ func (r *projectSchedulerSettingsResource) Create(ctx context.Context, req resou
 
 	err := schedulerSettingsService.UpdateProjectSchedulerSettings(
 		ctx,
-		&models.ProjectSchedulerSettings{SchedulerTimezone: scheduler_timezone},
+		&models.ProjectSchedulerSettings{
+			SchedulerTimezone: scheduler_timezone,
+			SchedulerEnabled:  getSchedulerEnabled(plan.SchedulerEnabled),
+		},
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -141,6 +195,19 @@ func (r *projectSchedulerSettingsResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	// Keep the current value of the scheduler toggle when it isn't configured
+	if plan.SchedulerEnabled.IsUnknown() || plan.SchedulerEnabled.IsNull() {
+		settings, err := schedulerSettingsService.GetProjectSchedulerSettings(ctx, project_uuid)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading scheduler settings",
+				fmt.Sprintf("Could not read scheduler settings for project UUID '%s', unexpected error: %s", project_uuid, err.Error()),
+			)
+			return
+		}
+		plan.SchedulerEnabled = types.BoolValue(settings.IsSchedulerEnabled())
+	}
+
 	// Assign the plan values to the state
 	stateId := getSchedulerSettingsResourceId(organization_uuid, project_uuid)
 	plan.ID = types.StringValue(stateId)
@@ -191,6 +258,7 @@ func (r *projectSchedulerSettingsResource) Read(ctx context.Context, req resourc
 	state.OrganizationUUID = types.StringValue(state.OrganizationUUID.ValueString())
 	state.ProjectUUID = types.StringValue(state.ProjectUUID.ValueString())
 	state.SchedulerTimezone = types.StringValue(settings.SchedulerTimezone)
+	state.SchedulerEnabled = types.BoolValue(settings.IsSchedulerEnabled())
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -220,7 +288,10 @@ func (r *projectSchedulerSettingsResource) Update(ctx context.Context, req resou
 	)
 	err := schedulerSettingsService.UpdateProjectSchedulerSettings(
 		ctx,
-		&models.ProjectSchedulerSettings{SchedulerTimezone: schedulerTimezone},
+		&models.ProjectSchedulerSettings{
+			SchedulerTimezone: schedulerTimezone,
+			SchedulerEnabled:  getSchedulerEnabled(plan.SchedulerEnabled),
+		},
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -253,13 +324,17 @@ func (r *projectSchedulerSettingsResource) Delete(ctx context.Context, req resou
 
 	// Set default values
 	defaultProjectSchedulerTimezone := models.DefaultProjectSchedulerTimezone
+	defaultSchedulerEnabled := true
 	schedulerSettingsService := services.NewProjectSchedulerSettingsService(
 		r.client,
 		state.ProjectUUID.ValueString(),
 	)
 	err := schedulerSettingsService.UpdateProjectSchedulerSettings(
 		ctx,
-		&models.ProjectSchedulerSettings{SchedulerTimezone: defaultProjectSchedulerTimezone},
+		&models.ProjectSchedulerSettings{
+			SchedulerTimezone: defaultProjectSchedulerTimezone,
+			SchedulerEnabled:  &defaultSchedulerEnabled,
+		},
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -302,6 +377,16 @@ func (r *projectSchedulerSettingsResource) ImportState(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organization_uuid)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scheduler_timezone"), importedSettings.SchedulerTimezone)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scheduler_enabled"), importedSettings.IsSchedulerEnabled())...)
+}
+
+// getSchedulerEnabled returns the configured scheduler toggle, or nil to keep the current value
+func getSchedulerEnabled(schedulerEnabled types.Bool) *bool {
+	if schedulerEnabled.IsNull() || schedulerEnabled.IsUnknown() {
+		return nil
+	}
+	enabled := schedulerEnabled.ValueBool()
+	return &enabled
 }
 
 func getSchedulerSettingsResourceId(organization_uuid string, settings_uuid string) string {
@@ -0,0 +1,314 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &projectSemanticLayerResource{}
+	_ resource.ResourceWithConfigure      = &projectSemanticLayerResource{}
+	_ resource.ResourceWithImportState    = &projectSemanticLayerResource{}
+	_ resource.ResourceWithValidateConfig = &projectSemanticLayerResource{}
+)
+
+func NewProjectSemanticLayerResource() resource.Resource {
+	return &projectSemanticLayerResource{}
+}
+
+// projectSemanticLayerResource defines the resource implementation.
+type projectSemanticLayerResource struct {
+	client *api.Client
+}
+
+// projectSemanticLayerResourceModel describes the resource data model.
+type projectSemanticLayerResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectUUID   types.String `tfsdk:"project_uuid"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Domain        types.String `tfsdk:"domain"`
+	Token         types.String `tfsdk:"token"`
+}
+
+func (r *projectSemanticLayerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_semantic_layer"
+}
+
+func (r *projectSemanticLayerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_project_semantic_layer.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the sync of dbt semantic layer metrics into a Lightdash project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/semantic_layer`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the metrics of the dbt semantic layer are synced into the project. Disabling it removes the semantic layer connection of the project.",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dbt Cloud environment of the semantic layer. Required when `enabled` is `true`.",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The domain of the dbt Cloud semantic layer API. Defaults to `%s`.", models.DefaultDbtSemanticLayerDomain),
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The dbt Cloud service token used to query the semantic layer. Required when `enabled` is `true`. It is not returned by the API, so changes made in the Lightdash UI are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *projectSemanticLayerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *projectSemanticLayerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config projectSemanticLayerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The connection settings are required to sync the metrics
+	if !config.Enabled.ValueBool() {
+		return
+	}
+	requiredAttributes := map[string]types.String{
+		"environment_id": config.EnvironmentID,
+		"token":          config.Token,
+	}
+	for attribute, value := range requiredAttributes {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing semantic layer attribute",
+				fmt.Sprintf("%s is required when enabled is true.", attribute),
+			)
+		}
+	}
+}
+
+func (r *projectSemanticLayerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectSemanticLayerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the semantic layer connection
+	if err := r.reconcileSemanticLayer(ctx, &plan, false); err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating semantic layer", fmt.Sprintf("Could not update the semantic layer of project %s, unexpected error: ", plan.ProjectUUID.ValueString()), err)
+		return
+	}
+
+	plan.ID = types.StringValue(getProjectSemanticLayerResourceId(plan.ProjectUUID.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSemanticLayerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectSemanticLayerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the project with its semantic layer connection
+	project, err := apiv1.GetProjectV1(ctx, r.client, state.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading semantic layer",
+			"Could not read semantic layer ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Refresh the non-sensitive settings, so that changes made in the UI show up as drift
+	readSemanticLayerConnection(&state, project.SemanticLayerConnection)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSemanticLayerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state projectSemanticLayerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the semantic layer connection
+	if err := r.reconcileSemanticLayer(ctx, &plan, state.Enabled.ValueBool()); err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error updating semantic layer", fmt.Sprintf("Could not update the semantic layer of project %s, unexpected error: ", plan.ProjectUUID.ValueString()), err)
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectSemanticLayerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state projectSemanticLayerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Stop syncing the metrics
+	if !state.Enabled.ValueBool() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Removing the semantic layer connection of project %s", state.ProjectUUID.ValueString()))
+	if err := apiv1.DeleteSemanticLayerConnectionV1(r.client, state.ProjectUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting semantic layer",
+			fmt.Sprintf("Could not remove the semantic layer connection of project %s, unexpected error: %s", state.ProjectUUID.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *projectSemanticLayerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Extract the resource ID
+	projectUuid, err := extractProjectSemanticLayerResourceId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error extracting resource ID",
+			"Could not extract resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set the resource attributes. The settings are populated by Read, except the token which is never returned.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
+}
+
+// reconcileSemanticLayer connects or disconnects the semantic layer of the project according to the plan
+func (r *projectSemanticLayerResource) reconcileSemanticLayer(ctx context.Context, plan *projectSemanticLayerResourceModel, wasEnabled bool) error {
+	projectUuid := plan.ProjectUUID.ValueString()
+	if !plan.Enabled.ValueBool() {
+		if !wasEnabled {
+			return nil
+		}
+		tflog.Info(ctx, fmt.Sprintf("Removing the semantic layer connection of project %s", projectUuid))
+		return apiv1.DeleteSemanticLayerConnectionV1(r.client, projectUuid)
+	}
+
+	domain := models.DefaultDbtSemanticLayerDomain
+	if !plan.Domain.IsNull() {
+		domain = plan.Domain.ValueString()
+	}
+	tflog.Info(ctx, fmt.Sprintf("Connecting project %s to the semantic layer of dbt Cloud environment %s", projectUuid, plan.EnvironmentID.ValueString()))
+	return apiv1.UpdateSemanticLayerConnectionV1(r.client, projectUuid, &models.SemanticLayerConnection{
+		Type:          models.SemanticLayerTypeDbt,
+		EnvironmentID: plan.EnvironmentID.ValueString(),
+		Domain:        domain,
+		Token:         plan.Token.ValueString(),
+	})
+}
+
+// readSemanticLayerConnection maps the semantic layer connection returned by the API into the model.
+// The token is never returned by the API, so it is kept from the state.
+func readSemanticLayerConnection(state *projectSemanticLayerResourceModel, remote *models.SemanticLayerConnection) {
+	if remote == nil || remote.Type != models.SemanticLayerTypeDbt {
+		state.Enabled = types.BoolValue(false)
+		return
+	}
+	state.Enabled = types.BoolValue(true)
+	state.EnvironmentID = types.StringValue(remote.EnvironmentID)
+	// The default domain is not written to the state when it isn't configured
+	if !state.Domain.IsNull() || remote.Domain != models.DefaultDbtSemanticLayerDomain {
+		state.Domain = types.StringValue(remote.Domain)
+	}
+}
+
+func getProjectSemanticLayerResourceId(projectUuid string) string {
+	return fmt.Sprintf("projects/%s/semantic_layer", projectUuid)
+}
+
+func extractProjectSemanticLayerResourceId(input string) (string, error) {
+	// Extract the captured groups
+	pattern := `^projects/([^/]+)/semantic_layer$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return "", fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return groups[0], nil
+}
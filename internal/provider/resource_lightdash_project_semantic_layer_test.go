@@ -0,0 +1,65 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectSemanticLayerResource_disabled(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_project_semantic_layer")
+	}
+
+	// Get the provider config
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+	projectUuid, err := getLightdashProjectUuid()
+	if err != nil {
+		t.Fatalf("Failed to get the project UUID: %v", err)
+	}
+
+	// Connecting the semantic layer requires a dbt Cloud token, so the test covers the disconnected project
+	createConfig010, err := ReadAccTestResource([]string{"resources", "lightdash_project_semantic_layer", "disabled", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get createConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig010,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_project_semantic_layer.test", "id", getProjectSemanticLayerResourceId(*projectUuid)),
+					resource.TestCheckResourceAttr("lightdash_project_semantic_layer.test", "project_uuid", *projectUuid),
+					resource.TestCheckResourceAttr("lightdash_project_semantic_layer.test", "enabled", "false"),
+				),
+			},
+			{
+				Config:            providerConfig + createConfig010,
+				ResourceName:      "lightdash_project_semantic_layer.test",
+				ImportState:       true,
+				ImportStateId:     getProjectSemanticLayerResourceId(*projectUuid),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
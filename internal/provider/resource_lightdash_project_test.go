@@ -15,11 +15,31 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	v1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
 func TestAccProjectResource_create(t *testing.T) {
@@ -86,15 +106,12 @@ func TestAccProjectResource_import(t *testing.T) {
 				ResourceName:      "lightdash_project.test_project",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The connection blocks are not returned by the API and are adopted from the configuration after import.
+				// Whether content was copied is only known on creation.
 				ImportStateVerifyIgnore: []string{
-					"dbt_connection.personal_access_token", // Sensitive field not returned by API
-					"dbt_connection.repository",            // Connection details not returned by API
-					"dbt_connection.branch",
-					"dbt_connection.project_sub_path",
-					"dbt_connection.host_domain",
-					"dbt_connection.target",
-					"dbt_connection.type",
-					"dbt_connection.authorization_method",
+					"dbt_connection",
+					"warehouse_connection",
+					"has_content_copy",
 				},
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					res, ok := state.RootModule().Resources["lightdash_project.test_project"]
@@ -109,3 +126,1474 @@ func TestAccProjectResource_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectResource_cliDeployed(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_project")
+	}
+
+	// The project is connected to the BigQuery warehouse of the warehouse acceptance tests
+	warehouseLocals, err := getWarehouseAccTestLocals(warehouseAccTestCases["bigquery"])
+	if err != nil {
+		t.Skipf("Skipping acceptance test for CLI deployed projects: %v", err)
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	cliDeployedConfig010, err := ReadAccTestResource([]string{"resources", "lightdash_project", "cli_deployed", "010_cli_deployed.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get cliDeployedConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + warehouseLocals + cliDeployedConfig010,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_project.cli_deployed", "project_uuid"),
+					resource.TestCheckResourceAttr("lightdash_project.cli_deployed", "dbt_connection.type", "dbt"),
+					resource.TestCheckResourceAttr("lightdash_project.cli_deployed", "dbt_connection.project_sub_path", "dbt"),
+					resource.TestCheckNoResourceAttr("lightdash_project.cli_deployed", "dbt_connection.repository"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateGithubAuthorization(t *testing.T) {
+	tests := []struct {
+		name                string
+		authorizationMethod types.String
+		personalAccessToken types.String
+		installationID      types.String
+		expectError         bool
+	}{
+		{
+			name:                "personal access token",
+			authorizationMethod: types.StringValue("personal_access_token"),
+			personalAccessToken: types.StringValue("token"),
+			installationID:      types.StringNull(),
+		},
+		{
+			name:                "missing personal access token",
+			authorizationMethod: types.StringValue("personal_access_token"),
+			personalAccessToken: types.StringNull(),
+			installationID:      types.StringNull(),
+			expectError:         true,
+		},
+		{
+			name:                "installation with ID",
+			authorizationMethod: types.StringValue("installation_id"),
+			personalAccessToken: types.StringNull(),
+			installationID:      types.StringValue("12345"),
+		},
+		{
+			name:                "installation of the organization",
+			authorizationMethod: types.StringValue("installation_id"),
+			personalAccessToken: types.StringNull(),
+			installationID:      types.StringNull(),
+		},
+		{
+			name:                "installation with personal access token",
+			authorizationMethod: types.StringValue("installation_id"),
+			personalAccessToken: types.StringValue("token"),
+			installationID:      types.StringNull(),
+			expectError:         true,
+		},
+		{
+			name:                "both credentials",
+			authorizationMethod: types.StringValue("personal_access_token"),
+			personalAccessToken: types.StringValue("token"),
+			installationID:      types.StringValue("12345"),
+			expectError:         true,
+		},
+		{
+			name:                "unsupported authorization method",
+			authorizationMethod: types.StringValue("ssh_key"),
+			personalAccessToken: types.StringNull(),
+			installationID:      types.StringNull(),
+			expectError:         true,
+		},
+		{
+			name:                "unknown authorization method",
+			authorizationMethod: types.StringUnknown(),
+			personalAccessToken: types.StringValue("token"),
+			installationID:      types.StringValue("12345"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			validateGithubAuthorization(&dbtConnectionModel{
+				AuthorizationMethod: tt.authorizationMethod,
+				PersonalAccessToken: tt.personalAccessToken,
+				InstallationID:      tt.installationID,
+			}, &diagnostics)
+			if diagnostics.HasError() != tt.expectError {
+				t.Errorf("validateGithubAuthorization() errors = %v, expectError %v", diagnostics.Errors(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateProjectCopyFlags(t *testing.T) {
+	preview := func() projectResourceModel {
+		return projectResourceModel{
+			Type:                                 types.StringValue("PREVIEW"),
+			UpstreamProjectUUID:                  types.StringValue("upstream-uuid"),
+			CopyContent:                          types.BoolNull(),
+			CopyWarehouseConnection:              types.BoolNull(),
+			OrganizationWarehouseCredentialsUUID: types.StringNull(),
+			OrganizationWarehouseCredentialsName: types.StringNull(),
+		}
+	}
+	tests := []struct {
+		name        string
+		config      func() projectResourceModel
+		expectError bool
+	}{
+		{
+			name:   "no copy flags",
+			config: preview,
+		},
+		{
+			name: "preview with upstream",
+			config: func() projectResourceModel {
+				config := preview()
+				config.CopyContent = types.BoolValue(false)
+				config.CopyWarehouseConnection = types.BoolValue(true)
+				return config
+			},
+		},
+		{
+			name: "default project",
+			config: func() projectResourceModel {
+				config := preview()
+				config.Type = types.StringValue("DEFAULT")
+				config.CopyContent = types.BoolValue(true)
+				return config
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown project type",
+			config: func() projectResourceModel {
+				config := preview()
+				config.Type = types.StringUnknown()
+				config.CopyContent = types.BoolValue(true)
+				return config
+			},
+		},
+		{
+			name: "missing upstream project",
+			config: func() projectResourceModel {
+				config := preview()
+				config.UpstreamProjectUUID = types.StringNull()
+				config.CopyWarehouseConnection = types.BoolValue(false)
+				return config
+			},
+			expectError: true,
+		},
+		{
+			name: "content copy selector without copying content",
+			config: func() projectResourceModel {
+				config := preview()
+				config.CopyContent = types.BoolValue(false)
+				config.ContentCopySelector = &contentCopySelectorModel{}
+				return config
+			},
+			expectError: true,
+		},
+		{
+			name: "copied warehouse connection with credentials",
+			config: func() projectResourceModel {
+				config := preview()
+				config.CopyWarehouseConnection = types.BoolValue(true)
+				config.OrganizationWarehouseCredentialsUUID = types.StringValue("credentials-uuid")
+				return config
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			config := tt.config()
+			validateProjectCopyFlags(&config, &diagnostics)
+			if diagnostics.HasError() != tt.expectError {
+				t.Errorf("validateProjectCopyFlags() errors = %v, expectError %v", diagnostics.Errors(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestGetContentCopySelector(t *testing.T) {
+	upstreamUUID := "upstream-uuid"
+	selector := &contentCopySelectorModel{
+		SpaceUUIDs:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("space-uuid")}),
+		DashboardUUIDs: types.SetNull(types.StringType),
+	}
+
+	contentCopySelector, diags := getContentCopySelector(context.Background(), selector, &upstreamUUID)
+	if diags.HasError() {
+		t.Fatalf("getContentCopySelector() errors = %v", diags.Errors())
+	}
+	marshalled, err := json.Marshal(contentCopySelector)
+	if err != nil {
+		t.Fatalf("Error marshaling the selector: %s", err.Error())
+	}
+	if string(marshalled) != `{"spaceUuids":["space-uuid"]}` {
+		t.Errorf("getContentCopySelector() = %s, want only the space UUIDs", marshalled)
+	}
+
+	// Nothing is selected, so all the content is copied
+	contentCopySelector, diags = getContentCopySelector(context.Background(), nil, &upstreamUUID)
+	if diags.HasError() || contentCopySelector != nil {
+		t.Errorf("getContentCopySelector() = %v, %v, want nil without errors", contentCopySelector, diags.Errors())
+	}
+
+	// There is no content to copy without an upstream or cloned project
+	_, diags = getContentCopySelector(context.Background(), selector, nil)
+	if !diags.HasError() {
+		t.Error("getContentCopySelector() expected an error without an upstream project")
+	}
+}
+
+func TestGetMismatchedEnvironment(t *testing.T) {
+	tests := []struct {
+		dataset             string
+		target              string
+		expectedEnvironment string
+		expectedMismatch    bool
+	}{
+		{dataset: "analytics_prod", target: "prod"},
+		{dataset: "analytics_production", target: "prod"},
+		{dataset: "analytics", target: "prod"},
+		{dataset: "analytics_dev", target: "custom_target"},
+		{dataset: "analytics_dev", target: "prod", expectedEnvironment: "dev", expectedMismatch: true},
+		{dataset: "STG-analytics", target: "production", expectedEnvironment: "staging", expectedMismatch: true},
+		{dataset: "dbt_dev_prod", target: "prod"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataset+"/"+tt.target, func(t *testing.T) {
+			environment, mismatch := getMismatchedEnvironment(tt.dataset, tt.target)
+			if environment != tt.expectedEnvironment || mismatch != tt.expectedMismatch {
+				t.Errorf("getMismatchedEnvironment(%q, %q) = (%q, %v), want (%q, %v)", tt.dataset, tt.target, environment, mismatch, tt.expectedEnvironment, tt.expectedMismatch)
+			}
+		})
+	}
+}
+
+func TestGetMaximumBytesBilled(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         types.Int64
+		expectOmitted bool
+	}{
+		{name: "null", value: types.Int64Null(), expectOmitted: true},
+		{name: "zero means unlimited", value: types.Int64Value(0), expectOmitted: true},
+		{name: "limit", value: types.Int64Value(1000000000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credentials := models.BigQueryCredentials{
+				Type:               "bigquery",
+				Project:            "my-project",
+				MaximumBytesBilled: getMaximumBytesBilled(tt.value),
+			}
+			payload, err := json.Marshal(credentials)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			omitted := !strings.Contains(string(payload), `"maximumBytesBilled"`)
+			if omitted != tt.expectOmitted {
+				t.Errorf("maximumBytesBilled omitted = %v, want %v, payload %s", omitted, tt.expectOmitted, payload)
+			}
+			if !tt.expectOmitted && !strings.Contains(string(payload), fmt.Sprintf(`"maximumBytesBilled":%d`, tt.value.ValueInt64())) {
+				t.Errorf("payload %s doesn't contain maximumBytesBilled %d", payload, tt.value.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestGetRecreatedProjectAttributes(t *testing.T) {
+	state := projectResourceModel{
+		Name:       types.StringValue("analytics"),
+		DbtVersion: types.StringValue("v1.9"),
+	}
+
+	// Adopting the connection blocks after an import is not a change
+	plan := state
+	plan.DbtConnection = &dbtConnectionModel{Type: types.StringValue("none")}
+	plan.RecreateOnUpdate = types.BoolValue(true)
+	if paths := getRecreatedProjectAttributes(&state, &plan); len(paths) != 0 {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want no paths", paths)
+	}
+
+	// Bumping dbt is an in-place update, which keeps the content of the project
+	plan.DbtVersion = types.StringValue("v1.10")
+	if paths := getRecreatedProjectAttributes(&state, &plan); len(paths) != 0 {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want no paths for a dbt_version change", paths)
+	}
+
+	plan.Name = types.StringValue("analytics-v2")
+	paths := getRecreatedProjectAttributes(&state, &plan)
+	if len(paths) != 1 || !paths.Contains(path.Root("name")) {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want name only", paths)
+	}
+
+	// A name managed outside Terraform never recreates the project
+	plan.IgnoreNameChanges = types.BoolValue(true)
+	if paths := getRecreatedProjectAttributes(&state, &plan); len(paths) != 0 {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want no paths", paths)
+	}
+
+	// The content copy selector only applies on creation
+	state.ContentCopySelector = &contentCopySelectorModel{
+		SpaceUUIDs:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("space-uuid")}),
+		DashboardUUIDs: types.SetNull(types.StringType),
+	}
+	plan.ContentCopySelector = nil
+	paths = getRecreatedProjectAttributes(&state, &plan)
+	if len(paths) != 1 || !paths.Contains(path.Root("content_copy_selector")) {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want content_copy_selector only", paths)
+	}
+
+	// A warehouse connection isn't adopted when the project uses organization warehouse credentials
+	state.ContentCopySelector = nil
+	state.OrganizationWarehouseCredentialsUUID = types.StringValue("credentials-uuid")
+	plan.OrganizationWarehouseCredentialsUUID = state.OrganizationWarehouseCredentialsUUID
+	plan.WarehouseConnection = &warehouseConnectionModel{Type: types.StringValue("bigquery")}
+	paths = getRecreatedProjectAttributes(&state, &plan)
+	if len(paths) != 1 || !paths.Contains(path.Root("warehouse_connection")) {
+		t.Errorf("getRecreatedProjectAttributes() = %v, want warehouse_connection only", paths)
+	}
+}
+
+func TestIsImportedProjectAdoption(t *testing.T) {
+	state := projectResourceModel{
+		Name:       types.StringValue("analytics"),
+		DbtVersion: types.StringValue("v1.9"),
+	}
+
+	// The connection blocks missing from the state after an import are adopted
+	plan := state
+	plan.DbtConnection = &dbtConnectionModel{Type: types.StringValue("none")}
+	plan.WarehouseConnection = &warehouseConnectionModel{Type: types.StringValue("bigquery")}
+	if !isImportedProjectAdoption(&state, &plan) {
+		t.Errorf("isImportedProjectAdoption() = false, want true for connection blocks missing from the state")
+	}
+
+	// Nothing is adopted when the state already matches the plan
+	if isImportedProjectAdoption(&plan, &plan) {
+		t.Errorf("isImportedProjectAdoption() = true, want false without differences")
+	}
+
+	// The warehouse connection is dropped by Read when the project uses organization warehouse credentials
+	state.OrganizationWarehouseCredentialsUUID = types.StringValue("credentials-uuid")
+	plan.OrganizationWarehouseCredentialsUUID = state.OrganizationWarehouseCredentialsUUID
+	if isImportedProjectAdoption(&state, &plan) {
+		t.Errorf("isImportedProjectAdoption() = true, want false for a warehouse connection the project doesn't use")
+	}
+}
+
+func TestAddConnectionTypeDriftWarnings(t *testing.T) {
+	state := projectResourceModel{
+		WarehouseConnection: &warehouseConnectionModel{Type: types.StringValue("BigQuery")},
+		DbtConnection:       &dbtConnectionModel{Type: types.StringValue("github")},
+		WarehouseType:       types.StringValue("bigquery"),
+		DbtType:             types.StringValue("github"),
+	}
+	var diags diag.Diagnostics
+	addConnectionTypeDriftWarnings(&state, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warnings for matching types, got: %v", diags)
+	}
+
+	// Connections swapped in the UI
+	state.WarehouseType = types.StringValue("snowflake")
+	state.DbtType = types.StringValue("gitlab")
+	addConnectionTypeDriftWarnings(&state, &diags)
+	if diags.WarningsCount() != 2 {
+		t.Errorf("Expected 2 warnings for swapped connections, got: %v", diags)
+	}
+
+	// Projects using organization warehouse credentials have no warehouse block to compare
+	diags = diag.Diagnostics{}
+	state.WarehouseConnection = nil
+	state.DbtType = types.StringValue("github")
+	addConnectionTypeDriftWarnings(&state, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warnings without warehouse block, got: %v", diags)
+	}
+}
+
+func TestRefreshWarehouseConnection(t *testing.T) {
+	stringPtr := func(value string) *string { return &value }
+	intPtr := func(value int) *int { return &value }
+	current := func() *warehouseConnectionModel {
+		return &warehouseConnectionModel{
+			Type:               types.StringValue("bigquery"),
+			Project:            types.StringValue("my-project"),
+			Dataset:            types.StringValue("analytics"),
+			KeyfileContents:    types.StringValue(`{"private_key": "secret"}`),
+			AuthenticationType: types.StringNull(),
+			Location:           types.StringNull(),
+			TimeoutSeconds:     types.Int64Null(),
+			MaximumBytesBilled: types.Int64Null(),
+			Priority:           types.StringValue("interactive"),
+			Retries:            types.Int64Value(3),
+			StartOfWeek:        types.Int64Null(),
+			Threads:            types.Int64Null(),
+		}
+	}
+	tests := []struct {
+		name     string
+		current  func() *warehouseConnectionModel
+		remote   *models.BigQueryCredentials
+		expected func() *warehouseConnectionModel
+	}{
+		{
+			name:    "unchanged",
+			current: current,
+			remote: &models.BigQueryCredentials{
+				Type:     "bigquery",
+				Project:  "my-project",
+				Dataset:  stringPtr("analytics"),
+				Priority: stringPtr("interactive"),
+				Retries:  intPtr(3),
+			},
+			expected: current,
+		},
+		{
+			name:    "unmanaged attributes stay null",
+			current: current,
+			remote: &models.BigQueryCredentials{
+				Type:           "bigquery",
+				Project:        "my-project",
+				Dataset:        stringPtr("analytics"),
+				Location:       stringPtr("US"),
+				TimeoutSeconds: intPtr(300),
+				Priority:       stringPtr("interactive"),
+				Retries:        intPtr(3),
+				Threads:        intPtr(8),
+			},
+			expected: current,
+		},
+		{
+			name:    "managed attributes removed in the UI",
+			current: current,
+			remote: &models.BigQueryCredentials{
+				Type:    "bigquery",
+				Project: "my-project",
+			},
+			expected: func() *warehouseConnectionModel {
+				expected := current()
+				expected.Dataset = types.StringNull()
+				expected.Priority = types.StringNull()
+				expected.Retries = types.Int64Null()
+				return expected
+			},
+		},
+		{
+			name: "case-insensitive values",
+			current: func() *warehouseConnectionModel {
+				connection := current()
+				connection.Type = types.StringValue("BigQuery")
+				return connection
+			},
+			remote: &models.BigQueryCredentials{
+				Type:     "bigquery",
+				Project:  "my-project",
+				Dataset:  stringPtr("analytics"),
+				Priority: stringPtr("INTERACTIVE"),
+				Retries:  intPtr(3),
+			},
+			expected: func() *warehouseConnectionModel {
+				connection := current()
+				connection.Type = types.StringValue("BigQuery")
+				return connection
+			},
+		},
+		{
+			name:    "changed in the UI",
+			current: current,
+			remote: &models.BigQueryCredentials{
+				Type:     "bigquery",
+				Project:  "other-project",
+				Dataset:  stringPtr("marts"),
+				Priority: stringPtr("batch"),
+				Retries:  intPtr(5),
+			},
+			expected: func() *warehouseConnectionModel {
+				expected := current()
+				expected.Project = types.StringValue("other-project")
+				expected.Dataset = types.StringValue("marts")
+				expected.Priority = types.StringValue("batch")
+				expected.Retries = types.Int64Value(5)
+				return expected
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.current()
+			refreshWarehouseConnection(actual, tt.remote)
+			if !reflect.DeepEqual(actual, tt.expected()) {
+				t.Errorf("refreshWarehouseConnection() = %+v, want %+v", actual, tt.expected())
+			}
+			// The keyfile is never returned by the API
+			if actual.KeyfileContents.ValueString() != `{"private_key": "secret"}` {
+				t.Errorf("Expected the keyfile to be preserved, got: %s", actual.KeyfileContents)
+			}
+		})
+	}
+}
+
+func TestReadWarehouseConnection(t *testing.T) {
+	current := func() *warehouseConnectionModel {
+		return &warehouseConnectionModel{
+			Type:               types.StringValue("BigQuery"),
+			Project:            types.StringValue("my-project"),
+			Dataset:            types.StringValue("analytics"),
+			KeyfileContents:    types.StringValue(`{"private_key": "secret"}`),
+			AuthenticationType: types.StringNull(),
+			Location:           types.StringNull(),
+			TimeoutSeconds:     types.Int64Null(),
+			MaximumBytesBilled: types.Int64Null(),
+			Priority:           types.StringNull(),
+			Retries:            types.Int64Null(),
+			StartOfWeek:        types.Int64Null(),
+			Threads:            types.Int64Null(),
+		}
+	}
+	tests := []struct {
+		name          string
+		current       func() *warehouseConnectionModel
+		remote        string
+		expected      func() *warehouseConnectionModel
+		expectWarning bool
+	}{
+		{
+			name:    "same type keeps the secrets",
+			current: current,
+			remote:  `{"type": "bigquery", "project": "other-project", "dataset": "analytics"}`,
+			expected: func() *warehouseConnectionModel {
+				expected := current()
+				expected.Project = types.StringValue("other-project")
+				return expected
+			},
+		},
+		{
+			name:    "connection missing from the state",
+			current: func() *warehouseConnectionModel { return nil },
+			remote:  `{"type": "bigquery", "project": "my-project", "dataset": "analytics", "threads": 8}`,
+			expected: func() *warehouseConnectionModel {
+				expected := current()
+				expected.Type = types.StringValue("bigquery")
+				expected.KeyfileContents = types.StringNull()
+				expected.Threads = types.Int64Value(8)
+				return expected
+			},
+		},
+		{
+			name: "type changed in the UI",
+			current: func() *warehouseConnectionModel {
+				connection := current()
+				connection.Type = types.StringValue("postgres")
+				return connection
+			},
+			remote: `{"type": "bigquery", "project": "my-project", "dataset": "analytics"}`,
+			expected: func() *warehouseConnectionModel {
+				// The secrets of the previous type don't apply to the new one
+				expected := current()
+				expected.Type = types.StringValue("bigquery")
+				expected.KeyfileContents = types.StringNull()
+				return expected
+			},
+		},
+		{
+			name:          "type without mapper",
+			current:       current,
+			remote:        `{"type": "snowflake", "account": "my-account", "schema": "analytics"}`,
+			expected:      current,
+			expectWarning: true,
+		},
+		{
+			name:          "invalid connection",
+			current:       current,
+			remote:        `{"type": "bigquery", "project": 42}`,
+			expected:      current,
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := &models.WarehouseConnection{}
+			if err := json.Unmarshal([]byte(tt.remote), remote); err != nil {
+				t.Fatalf("Error unmarshaling the warehouse connection: %s", err.Error())
+			}
+			var diagnostics diag.Diagnostics
+			actual := readWarehouseConnection(context.Background(), tt.current(), remote, &diagnostics)
+			if !reflect.DeepEqual(actual, tt.expected()) {
+				t.Errorf("readWarehouseConnection() = %+v, want %+v", actual, tt.expected())
+			}
+			if (diagnostics.WarningsCount() > 0) != tt.expectWarning {
+				t.Errorf("readWarehouseConnection() warnings = %v, expectWarning %v", diagnostics.Warnings(), tt.expectWarning)
+			}
+		})
+	}
+}
+
+func TestGetUnsupportedWarehouseFields(t *testing.T) {
+	connection := &warehouseConnectionModel{
+		Type:            types.StringValue("bigquery"),
+		Project:         types.StringValue("my-project"),
+		Dataset:         types.StringValue("analytics"),
+		KeyfileContents: types.StringValue("{}"),
+		StartOfWeek:     types.Int64Value(0),
+		Threads:         types.Int64Value(4),
+	}
+	if fields := getUnsupportedWarehouseFields(connection); len(fields) != 0 {
+		t.Errorf("getUnsupportedWarehouseFields() = %v, want no fields for bigquery", fields)
+	}
+
+	connection.Type = types.StringValue("snowflake")
+	fields := getUnsupportedWarehouseFields(connection)
+	expected := []string{"dataset", "keyfile_contents", "project"}
+	if strings.Join(fields, ",") != strings.Join(expected, ",") {
+		t.Errorf("getUnsupportedWarehouseFields() = %v, want %v", fields, expected)
+	}
+
+	// dbt has no threads setting for Trino
+	connection.Type = types.StringValue("trino")
+	fields = getUnsupportedWarehouseFields(connection)
+	expected = []string{"dataset", "keyfile_contents", "project", "threads"}
+	if strings.Join(fields, ",") != strings.Join(expected, ",") {
+		t.Errorf("getUnsupportedWarehouseFields() = %v, want %v", fields, expected)
+	}
+
+	// Unknown fields are only checked once they are known
+	connection.Dataset = types.StringUnknown()
+	connection.Threads = types.Int64Unknown()
+	fields = getUnsupportedWarehouseFields(connection)
+	expected = []string{"keyfile_contents", "project"}
+	if strings.Join(fields, ",") != strings.Join(expected, ",") {
+		t.Errorf("getUnsupportedWarehouseFields() = %v, want %v", fields, expected)
+	}
+}
+
+func TestProjectResourceValidateConfigWarehouseFieldsWithoutDbtConnection(t *testing.T) {
+	ctx := context.Background()
+	r := &projectResource{}
+	state := newResourceState(t, r, map[string]attr.Value{
+		"name": types.StringValue("Analytics"),
+	})
+	if diags := state.SetAttribute(ctx, path.Root("warehouse_connection"), &warehouseConnectionModel{
+		Type:    types.StringValue("snowflake"),
+		Dataset: types.StringValue("analytics"),
+	}); diags.HasError() {
+		t.Fatalf("Error setting warehouse_connection: %v", diags)
+	}
+
+	resp := &fwresource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("ValidateConfig() expected an error for a dataset on a snowflake connection without dbt_connection")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Warehouse connection fields don't match the type" {
+		t.Errorf("ValidateConfig() error = %q, want the warehouse fields error", summary)
+	}
+}
+
+func TestFindOrganizationWarehouseCredentialsUuidsByName(t *testing.T) {
+	credentials := []v1.ListOrganizationWarehouseCredentialsV1Results{
+		{OrganizationWarehouseCredentialsUUID: "uuid-3", Name: "bigquery-prod"},
+		{OrganizationWarehouseCredentialsUUID: "uuid-2", Name: "bigquery-dev"},
+		{OrganizationWarehouseCredentialsUUID: "uuid-1", Name: "bigquery-prod"},
+	}
+	if uuids := findOrganizationWarehouseCredentialsUuidsByName(credentials, "bigquery-dev"); strings.Join(uuids, ",") != "uuid-2" {
+		t.Errorf("findOrganizationWarehouseCredentialsUuidsByName() = %v, want [uuid-2]", uuids)
+	}
+	if uuids := findOrganizationWarehouseCredentialsUuidsByName(credentials, "bigquery-prod"); strings.Join(uuids, ",") != "uuid-1,uuid-3" {
+		t.Errorf("findOrganizationWarehouseCredentialsUuidsByName() = %v, want [uuid-1 uuid-3]", uuids)
+	}
+	// The name is matched exactly
+	if uuids := findOrganizationWarehouseCredentialsUuidsByName(credentials, "BigQuery-Dev"); len(uuids) != 0 {
+		t.Errorf("findOrganizationWarehouseCredentialsUuidsByName() = %v, want no UUIDs", uuids)
+	}
+}
+
+func TestParseKeyfileContents(t *testing.T) {
+	keyfile := `{"type": "service_account", "project_id": "my-project"}`
+	tests := []struct {
+		name        string
+		contents    string
+		expectError bool
+	}{
+		{name: "json", contents: keyfile},
+		{name: "base64", contents: base64.StdEncoding.EncodeToString([]byte(keyfile))},
+		{name: "base64 with trailing newline", contents: base64.StdEncoding.EncodeToString([]byte(keyfile)) + "\n"},
+		{name: "invalid json", contents: `{"type": `, expectError: true},
+		{name: "base64 of invalid json", contents: base64.StdEncoding.EncodeToString([]byte("not json")), expectError: true},
+		{name: "json array", contents: `["service_account"]`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyfileMap, err := parseKeyfileContents(tt.contents)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("parseKeyfileContents() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && keyfileMap["project_id"] != "my-project" {
+				t.Errorf("parseKeyfileContents() project_id = %v, want my-project", keyfileMap["project_id"])
+			}
+		})
+	}
+}
+
+func TestReadDbtConnection(t *testing.T) {
+	state := &dbtConnectionModel{
+		Type:          types.StringValue("dbt_cloud_ide"),
+		EnvironmentID: types.StringValue("111"),
+		APIKey:        types.StringValue("dbtc_secret"),
+	}
+
+	// The environment repointed in the UI is refreshed, while the API key is kept from the state
+	environmentID := "222"
+	readDbtConnection(state, &v1.GetProjectV1DbtConnection{Type: "dbt_cloud_ide", EnvironmentID: &environmentID})
+	if state.EnvironmentID.ValueString() != "222" {
+		t.Errorf("environment_id = %s, want 222", state.EnvironmentID.ValueString())
+	}
+	if state.APIKey.ValueString() != "dbtc_secret" {
+		t.Errorf("api_key = %s, want the value of the state", state.APIKey.ValueString())
+	}
+
+	// A connection of another type is reported by dbt_type instead
+	readDbtConnection(state, &v1.GetProjectV1DbtConnection{Type: "github"})
+	if state.EnvironmentID.ValueString() != "222" || state.APIKey.ValueString() != "dbtc_secret" {
+		t.Errorf("readDbtConnection() changed a connection of another type: %+v", state)
+	}
+}
+
+func TestResolveCreatedDbtVersion(t *testing.T) {
+	if got := resolveCreatedDbtVersion("auto", "v1.9"); got != "v1.9" {
+		t.Errorf("resolveCreatedDbtVersion(auto, v1.9) = %s, want v1.9", got)
+	}
+	if got := resolveCreatedDbtVersion("auto", ""); got != "auto" {
+		t.Errorf("resolveCreatedDbtVersion(auto, \"\") = %s, want auto", got)
+	}
+	if got := resolveCreatedDbtVersion("v1.10", "v1.9"); got != "v1.10" {
+		t.Errorf("resolveCreatedDbtVersion(v1.10, v1.9) = %s, want v1.10", got)
+	}
+}
+
+func TestResolveDbtVersion(t *testing.T) {
+	newestKnown := models.SupportedDbtVersions[len(models.SupportedDbtVersions)-1]
+	tests := []struct {
+		name       string
+		dbtVersion string
+		status     int
+		body       string
+		expected   string
+	}{
+		{
+			name:       "versions reported by the server",
+			dbtVersion: "latest",
+			status:     http.StatusOK,
+			body:       `{"status": "ok", "results": {"healthy": true, "supportedDbtVersions": ["v1.9", "v1.11", "v1.10"]}}`,
+			expected:   "v1.11",
+		},
+		{
+			name:       "server without dbt versions",
+			dbtVersion: "latest",
+			status:     http.StatusOK,
+			body:       `{"status": "ok", "results": {"healthy": true}}`,
+			expected:   newestKnown,
+		},
+		{
+			name:       "unreachable health endpoint",
+			dbtVersion: "latest",
+			status:     http.StatusForbidden,
+			body:       `{"status": "error"}`,
+			expected:   newestKnown,
+		},
+		{
+			name:       "concrete version",
+			dbtVersion: "v1.8",
+			expected:   "v1.8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			if actual := resolveDbtVersion(context.Background(), client, tt.dbtVersion); actual != tt.expected {
+				t.Errorf("resolveDbtVersion() = %s, want %s", actual, tt.expected)
+			}
+			// Only the alias needs the server
+			if tt.dbtVersion != "latest" && requests.Load() != 0 {
+				t.Errorf("Expected no request for dbt version %s, got %d", tt.dbtVersion, requests.Load())
+			}
+		})
+	}
+}
+
+func TestWarnPersonalAccessTokenWithGithubApp(t *testing.T) {
+	tests := []struct {
+		name           string
+		dbtConnection  *models.DbtGithubProjectConfig
+		expectRequests int32
+		expectWarning  bool
+	}{
+		{
+			name:           "personal access token",
+			dbtConnection:  &models.DbtGithubProjectConfig{Type: models.DbtProjectTypeGithub, AuthorizationMethod: "personal_access_token"},
+			expectRequests: 1,
+			expectWarning:  true,
+		},
+		{
+			name:          "github app installation",
+			dbtConnection: &models.DbtGithubProjectConfig{Type: models.DbtProjectTypeGithub, AuthorizationMethod: "installation_id"},
+		},
+		{
+			name:          "gitlab",
+			dbtConnection: &models.DbtGithubProjectConfig{Type: models.DbtProjectTypeGitlab},
+		},
+		{
+			name: "no dbt connection",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				_, _ = w.Write([]byte(`{"status": "ok", "results": {"healthy": true, "hasGithub": true}}`))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			var diagnostics diag.Diagnostics
+			r := &projectResource{}
+			r.warnPersonalAccessTokenWithGithubApp(context.Background(), client, tt.dbtConnection, &diagnostics)
+			if requests.Load() != tt.expectRequests {
+				t.Errorf("Expected %d health requests, got %d", tt.expectRequests, requests.Load())
+			}
+			if (diagnostics.WarningsCount() > 0) != tt.expectWarning {
+				t.Errorf("warnPersonalAccessTokenWithGithubApp() warnings = %v, expectWarning %v", diagnostics.Warnings(), tt.expectWarning)
+			}
+		})
+	}
+}
+
+func TestIsUnsupportedDbtVersionAuto(t *testing.T) {
+	tests := []struct {
+		name       string
+		dbtVersion string
+		err        error
+		expected   bool
+	}{
+		{
+			name:       "rejected dbt version field",
+			dbtVersion: "auto",
+			err:        fmt.Errorf("request failed: %w", &api.StatusError{StatusCode: http.StatusBadRequest, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.dbtVersion": {"message": "Invalid enum value"}}}}`)}),
+			expected:   true,
+		},
+		{
+			name:       "rejected dbt version message",
+			dbtVersion: "auto",
+			err:        &api.StatusError{StatusCode: http.StatusUnprocessableEntity, Body: []byte(`{"error": {"message": "Unsupported dbt version auto"}}`)},
+			expected:   true,
+		},
+		{
+			name:       "other rejected field",
+			dbtVersion: "auto",
+			err:        &api.StatusError{StatusCode: http.StatusBadRequest, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.warehouseConnection.dataset": {"message": "Required"}}}}`)},
+		},
+		{
+			name:       "other validation message",
+			dbtVersion: "auto",
+			err:        &api.StatusError{StatusCode: http.StatusBadRequest, Body: []byte(`{"error": {"message": "Project name is too long"}}`)},
+		},
+		{
+			name:       "other dbt version",
+			dbtVersion: "v1.10",
+			err:        &api.StatusError{StatusCode: http.StatusBadRequest, Body: []byte(`{"error": {"message": "Invalid", "data": {"body.dbtVersion": {"message": "Invalid enum value"}}}}`)},
+		},
+		{
+			name:       "server error",
+			dbtVersion: "auto",
+			err:        &api.StatusError{StatusCode: http.StatusInternalServerError, Body: []byte(`{"error": {"message": "dbtVersion"}}`)},
+		},
+		{
+			name:       "network error",
+			dbtVersion: "auto",
+			err:        errors.New("connection refused"),
+		},
+		{
+			name:       "no error",
+			dbtVersion: "auto",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := isUnsupportedDbtVersionAuto(tt.dbtVersion, tt.err); actual != tt.expected {
+				t.Errorf("isUnsupportedDbtVersionAuto() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetExploreCount(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		fallback      types.Int64
+		expected      types.Int64
+		expectWarning bool
+	}{
+		{
+			name:     "compiled explores",
+			status:   http.StatusOK,
+			body:     `{"status": "ok", "results": [{"name": "orders"}, {"name": "customers"}]}`,
+			fallback: types.Int64Value(1),
+			expected: types.Int64Value(2),
+		},
+		{
+			name:     "no explores",
+			status:   http.StatusOK,
+			body:     `{"status": "ok", "results": []}`,
+			fallback: types.Int64Value(1),
+			expected: types.Int64Value(0),
+		},
+		{
+			name:          "listing fails",
+			status:        http.StatusForbidden,
+			body:          `{"status": "error"}`,
+			fallback:      types.Int64Value(1),
+			expected:      types.Int64Value(1),
+			expectWarning: true,
+		},
+		{
+			name:          "listing fails without a previous count",
+			status:        http.StatusForbidden,
+			body:          `{"status": "error"}`,
+			fallback:      types.Int64Unknown(),
+			expected:      types.Int64Null(),
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/projects/project-uuid/explores" {
+					t.Errorf("Unexpected path: %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			var diagnostics diag.Diagnostics
+			r := &projectResource{}
+			count := r.getExploreCount(context.Background(), client, "project-uuid", tt.fallback, &diagnostics)
+			if !count.Equal(tt.expected) {
+				t.Errorf("getExploreCount() = %v, want %v", count, tt.expected)
+			}
+			if (diagnostics.WarningsCount() > 0) != tt.expectWarning {
+				t.Errorf("getExploreCount() warnings = %v, expectWarning %v", diagnostics.Warnings(), tt.expectWarning)
+			}
+		})
+	}
+}
+
+func TestWaitForProjectCompile(t *testing.T) {
+	var listings atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The explores appear after the third listing, like after a compile in the background
+		if listings.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"status": "ok", "results": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "ok", "results": [{"name": "orders"}, {"name": "customers"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	var diagnostics diag.Diagnostics
+	explores, err := waitForProjectCompile(context.Background(), client, "project-uuid", time.Millisecond, &diagnostics)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if len(explores) != 2 || listings.Load() != 3 {
+		t.Errorf("Expected 2 explores after 3 listings, got %d after %d", len(explores), listings.Load())
+	}
+
+	// The wait is bounded by the context
+	listings.Store(-100)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := waitForProjectCompile(ctx, client, "project-uuid", time.Millisecond, &diagnostics); err == nil || !strings.Contains(err.Error(), "create timeout") {
+		t.Errorf("Expected a timeout error, got: %v", err)
+	}
+}
+
+func TestWaitForProjectCompileStops(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{
+			name:   "missing project",
+			status: http.StatusNotFound,
+			body:   `{"status": "error", "error": {"name": "NotFoundError", "message": "Project not found"}}`,
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			body:   `{"status": "error", "error": {"name": "ForbiddenError", "message": "Forbidden"}}`,
+		},
+		{
+			name:   "failed compile",
+			status: http.StatusOK,
+			body:   `{"status": "ok", "results": [{"name": "orders", "errors": [{"type": "CompileError", "message": "column not found"}]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var listings atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				listings.Add(1)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			// The wait fails on the first listing instead of waiting for the timeout
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			var diagnostics diag.Diagnostics
+			if _, err := waitForProjectCompile(ctx, client, "project-uuid", time.Millisecond, &diagnostics); err == nil || strings.Contains(err.Error(), "create timeout") {
+				t.Errorf("Expected an error before the timeout, got: %v", err)
+			}
+			if listings.Load() != 1 {
+				t.Errorf("Expected 1 listing, got %d", listings.Load())
+			}
+		})
+	}
+}
+
+func TestCheckCompiledExplores(t *testing.T) {
+	failed := models.Explore{Name: "orders", Errors: []models.ExploreError{{Type: "CompileError", Message: "column not found"}}}
+	compiled := models.Explore{Name: "customers"}
+	tests := []struct {
+		name          string
+		explores      []models.Explore
+		expectError   bool
+		expectWarning bool
+	}{
+		{
+			name:     "all compiled",
+			explores: []models.Explore{compiled},
+		},
+		{
+			name:          "some failed",
+			explores:      []models.Explore{compiled, failed},
+			expectWarning: true,
+		},
+		{
+			name:        "all failed",
+			explores:    []models.Explore{failed},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			err := checkCompiledExplores(tt.explores, &diagnostics)
+			if (err != nil) != tt.expectError {
+				t.Errorf("checkCompiledExplores() error = %v, expectError %v", err, tt.expectError)
+			}
+			if (diagnostics.WarningsCount() > 0) != tt.expectWarning {
+				t.Errorf("checkCompiledExplores() warnings = %v, expectWarning %v", diagnostics.Warnings(), tt.expectWarning)
+			}
+		})
+	}
+}
+
+func TestProjectResourceImportState(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expectError bool
+	}{
+		{
+			name: "valid ID",
+			id:   "organizations/org-uuid/projects/project-uuid",
+		},
+		{
+			name:        "missing organization",
+			id:          "projects/project-uuid",
+			expectError: true,
+		},
+		{
+			name:        "trailing path",
+			id:          "organizations/org-uuid/projects/project-uuid/spaces",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := &fwresource.ImportStateResponse{State: newResourceState(t, NewProjectResource(), nil)}
+			(&projectResource{}).ImportState(ctx, fwresource.ImportStateRequest{ID: tt.id}, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("ImportState() errors = %v, expectError %v", resp.Diagnostics.Errors(), tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			var organizationUuid, projectUuid types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization_uuid"), &organizationUuid)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_uuid"), &projectUuid)...)
+			if organizationUuid.ValueString() != "org-uuid" || projectUuid.ValueString() != "project-uuid" {
+				t.Errorf("ImportState() = %s, %s, want org-uuid, project-uuid", organizationUuid, projectUuid)
+			}
+		})
+	}
+}
+
+func TestProjectResourceGetClient(t *testing.T) {
+	host := "https://app.lightdash.cloud"
+	token := "provider-token"
+	client, err := api.NewClient(&host, &token, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.DefaultWarehouseCredentialsUUID = "credentials-uuid"
+	r := &projectResource{client: client}
+
+	// The provider client is used without a project host
+	if got, err := r.getClient(types.StringNull(), types.StringNull()); err != nil || got != client {
+		t.Errorf("getClient() = %v, %v, want the provider client", got, err)
+	}
+
+	// Another host requires its own token
+	if _, err := r.getClient(types.StringValue("https://lightdash.example.com"), types.StringNull()); err == nil {
+		t.Error("getClient() expected an error without host_token")
+	}
+
+	other, err := r.getClient(types.StringValue("https://lightdash.example.com/"), types.StringValue("host-token"))
+	if err != nil {
+		t.Fatalf("getClient() unexpected error: %v", err)
+	}
+	if other.HostUrl != "https://lightdash.example.com" || other.Token != "host-token" || other.DefaultWarehouseCredentialsUUID != "" {
+		t.Errorf("getClient() = %s, %s, %q, want the project host with its own token and no default credentials", other.HostUrl, other.Token, other.DefaultWarehouseCredentialsUUID)
+	}
+}
+
+func TestProjectResourceRead(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		organizationUuid string
+		readRefresh      types.Bool
+		expectError      bool
+		expectRemoved    bool
+		expectRefresh    bool
+	}{
+		{
+			name:             "refreshed",
+			status:           http.StatusOK,
+			organizationUuid: "org-uuid",
+			readRefresh:      types.BoolNull(),
+			expectRefresh:    true,
+		},
+		{
+			name:             "organization mismatch",
+			status:           http.StatusOK,
+			organizationUuid: "other-org-uuid",
+			readRefresh:      types.BoolNull(),
+			expectError:      true,
+		},
+		{
+			name:             "refresh turned off",
+			status:           http.StatusOK,
+			organizationUuid: "org-uuid",
+			readRefresh:      types.BoolValue(false),
+		},
+		{
+			name:          "deleted project",
+			status:        http.StatusNotFound,
+			readRefresh:   types.BoolNull(),
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var exploreListings atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/projects/project-uuid":
+					w.WriteHeader(tt.status)
+					_, _ = fmt.Fprintf(w, `{"status": "ok", "results": {"organizationUuid": %q, "projectUuid": "project-uuid", "name": "analytics", "type": "DEFAULT"}}`, tt.organizationUuid)
+				case "/api/v1/projects/project-uuid/explores":
+					exploreListings.Add(1)
+					_, _ = w.Write([]byte(`{"status": "ok", "results": [{"name": "orders"}]}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			ctx := context.Background()
+			state := newResourceState(t, NewProjectResource(), map[string]attr.Value{
+				"id":                types.StringValue("organizations/org-uuid/projects/project-uuid"),
+				"organization_uuid": types.StringValue("org-uuid"),
+				"project_uuid":      types.StringValue("project-uuid"),
+				"read_refresh":      tt.readRefresh,
+			})
+			resp := &fwresource.ReadResponse{State: state}
+			(&projectResource{client: client}).Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("Read() errors = %v, expectError %v", resp.Diagnostics.Errors(), tt.expectError)
+			}
+			if resp.State.Raw.IsNull() != tt.expectRemoved {
+				t.Fatalf("Read() removed = %v, expectRemoved %v", resp.State.Raw.IsNull(), tt.expectRemoved)
+			}
+			if tt.expectError || tt.expectRemoved {
+				return
+			}
+
+			var importId, name types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("import_id"), &importId)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+			// The import ID is set even when the attributes are not refreshed
+			if importId.ValueString() != "organizations/org-uuid/projects/project-uuid" {
+				t.Errorf("Expected the import ID to be set, got: %s", importId)
+			}
+			if (name.ValueString() == "analytics") != tt.expectRefresh {
+				t.Errorf("Read() name = %s, expectRefresh %v", name, tt.expectRefresh)
+			}
+			if (exploreListings.Load() > 0) != tt.expectRefresh {
+				t.Errorf("Read() listed explores %d times, expectRefresh %v", exploreListings.Load(), tt.expectRefresh)
+			}
+		})
+	}
+}
+
+func TestProjectResourceReadClone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/projects/project-uuid":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": {"organizationUuid": "org-uuid", "projectUuid": "project-uuid", "name": "analytics-clone", "type": "DEFAULT", "upstreamProjectUuid": "source-uuid"}}`))
+		case "/api/v1/projects/project-uuid/explores":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	ctx := context.Background()
+	state := newResourceState(t, NewProjectResource(), map[string]attr.Value{
+		"id":                      types.StringValue("organizations/org-uuid/projects/project-uuid"),
+		"organization_uuid":       types.StringValue("org-uuid"),
+		"project_uuid":            types.StringValue("project-uuid"),
+		"clone_from_project_uuid": types.StringValue("source-uuid"),
+	})
+	resp := &fwresource.ReadResponse{State: state}
+	(&projectResource{client: client}).Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors = %v", resp.Diagnostics.Errors())
+	}
+
+	// The source of the clone is exposed as the linked upstream project, not as a configured upstream project
+	var upstreamProjectUuid, linkedUpstreamProjectUuid types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("upstream_project_uuid"), &upstreamProjectUuid)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("linked_upstream_project_uuid"), &linkedUpstreamProjectUuid)...)
+	if !upstreamProjectUuid.IsNull() {
+		t.Errorf("Expected upstream_project_uuid to be null, got: %s", upstreamProjectUuid)
+	}
+	if linkedUpstreamProjectUuid.ValueString() != "source-uuid" {
+		t.Errorf("Expected linked_upstream_project_uuid: source-uuid, got: %s", linkedUpstreamProjectUuid)
+	}
+}
+
+func TestProjectResourceUpdateRejectedBeforeInPlaceChanges(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	ctx := context.Background()
+	attributes := map[string]attr.Value{
+		"id":                types.StringValue("organizations/org-uuid/projects/project-uuid"),
+		"organization_uuid": types.StringValue("org-uuid"),
+		"project_uuid":      types.StringValue("project-uuid"),
+		"name":              types.StringValue("analytics"),
+		"dbt_version":       types.StringValue("v1.9"),
+		"query_row_limit":   types.Int64Value(500),
+	}
+	state := newResourceState(t, NewProjectResource(), attributes)
+
+	// The rename can't be applied in place, so neither the query row limit nor the dbt version is updated
+	attributes["name"] = types.StringValue("analytics-v2")
+	attributes["dbt_version"] = types.StringValue("v1.10")
+	attributes["query_row_limit"] = types.Int64Value(1000)
+	planState := newResourceState(t, NewProjectResource(), attributes)
+	plan := tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}
+
+	resp := &fwresource.UpdateResponse{State: state}
+	(&projectResource{client: client}).Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected the update to be rejected")
+	}
+	if requests.Load() != 0 {
+		t.Errorf("Expected no request to Lightdash, got %d", requests.Load())
+	}
+}
+
+func TestProjectResourceCreateKeepsIdentifiersOnFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/org/projects":
+			_, _ = w.Write([]byte(`{"status": "ok", "results": {"hasContentCopy": false, "project": {"projectUuid": "project-uuid"}}}`))
+		default:
+			// Setting the query row limit fails after the project was created
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status": "error", "error": {"name": "ParameterError", "message": "Invalid query row limit"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	ctx := context.Background()
+	planState := newResourceState(t, NewProjectResource(), map[string]attr.Value{
+		"organization_uuid": types.StringValue("org-uuid"),
+		"name":              types.StringValue("analytics"),
+		"type":              types.StringValue("DEFAULT"),
+		"dbt_version":       types.StringValue("v1.10"),
+		"query_row_limit":   types.Int64Value(500),
+	})
+	plan := tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}
+	resp := &fwresource.CreateResponse{State: newResourceState(t, NewProjectResource(), nil)}
+	(&projectResource{client: client}).Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected the query row limit error")
+	}
+
+	// The created project stays tracked, so it can be refreshed or destroyed
+	var id, projectUuid types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_uuid"), &projectUuid)...)
+	if id.ValueString() != "organizations/org-uuid/projects/project-uuid" || projectUuid.ValueString() != "project-uuid" {
+		t.Errorf("Expected the identifiers of the created project in the state, got: %s, %s", id, projectUuid)
+	}
+}
+
+func TestProjectResourceCreateCopiedWarehouseConnection(t *testing.T) {
+	var createBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/org/projects" {
+			createBody, _ = io.ReadAll(r.Body)
+		}
+		_, _ = w.Write([]byte(`{"status": "ok", "results": {"hasContentCopy": false, "project": {"projectUuid": "project-uuid"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err.Error())
+	}
+	client.DefaultWarehouseCredentialsUUID = "credentials-uuid"
+	ctx := context.Background()
+	planState := newResourceState(t, NewProjectResource(), map[string]attr.Value{
+		"organization_uuid":         types.StringValue("org-uuid"),
+		"name":                      types.StringValue("analytics-preview"),
+		"type":                      types.StringValue("PREVIEW"),
+		"dbt_version":               types.StringValue("v1.10"),
+		"upstream_project_uuid":     types.StringValue("upstream-uuid"),
+		"copy_warehouse_connection": types.BoolValue(true),
+	})
+	plan := tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}
+	resp := &fwresource.CreateResponse{State: newResourceState(t, NewProjectResource(), nil)}
+	(&projectResource{client: client}).Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+	// The connection copied from the upstream project is not replaced by the default credentials
+	if createBody == nil {
+		t.Fatalf("Expected the project to be created, got: %v", resp.Diagnostics.Errors())
+	}
+	if strings.Contains(string(createBody), "organizationWarehouseCredentialsUuid") {
+		t.Errorf("Expected no organization warehouse credentials in the request, got: %s", createBody)
+	}
+	if !strings.Contains(string(createBody), `"copyWarehouseConnectionFromUpstreamProject":true`) {
+		t.Errorf("Expected the warehouse connection to be copied, got: %s", createBody)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// warehouseAccTestCase describes the acceptance test of a warehouse type.
+// The credentials are read from environment variables and exposed to the fixture as `local.warehouse.<key>`.
+type warehouseAccTestCase struct {
+	warehouseType string
+	// envVars maps the keys of `local.warehouse` to the environment variables holding their values
+	envVars map[string]string
+	// checks are the attributes expected on the `lightdash_project.warehouse__<warehouse type>` resource
+	checks map[string]string
+}
+
+var warehouseAccTestCases = map[string]warehouseAccTestCase{
+	"bigquery": {
+		warehouseType: "bigquery",
+		envVars: map[string]string{
+			"project":          "LIGHTDASH_TEST_BIGQUERY_PROJECT",
+			"dataset":          "LIGHTDASH_TEST_BIGQUERY_DATASET",
+			"keyfile_contents": "LIGHTDASH_TEST_BIGQUERY_KEYFILE_CONTENTS",
+		},
+		checks: map[string]string{
+			"warehouse_connection.type": "bigquery",
+		},
+	},
+}
+
+func TestAccProjectResource_bigquery(t *testing.T) {
+	testAccProjectResourceWarehouse(t, warehouseAccTestCases["bigquery"])
+}
+
+// testAccProjectResourceWarehouse creates a project connected to the warehouse of the test case.
+// It is skipped when the credentials of the warehouse are not set.
+// Projects are not deleted by Terraform, so the created projects must be cleaned up in Lightdash.
+func testAccProjectResourceWarehouse(t *testing.T, testCase warehouseAccTestCase) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_project")
+	}
+
+	warehouseLocals, err := getWarehouseAccTestLocals(testCase)
+	if err != nil {
+		t.Skipf("Skipping acceptance test for %s warehouses: %v", testCase.warehouseType, err)
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	warehouseConfig, err := ReadAccTestResource([]string{"resources", "lightdash_project", "warehouses", testCase.warehouseType + ".tf"})
+	if err != nil {
+		t.Fatalf("Failed to get warehouseConfig: %v", err)
+	}
+
+	resourceName := "lightdash_project.warehouse__" + testCase.warehouseType
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(resourceName, "project_uuid"),
+	}
+	for attribute, value := range testCase.checks {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, attribute, value))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + warehouseLocals + warehouseConfig,
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// getWarehouseAccTestLocals returns the `local.warehouse` block of the test case built from the environment variables
+func getWarehouseAccTestLocals(testCase warehouseAccTestCase) (string, error) {
+	keys := make([]string, 0, len(testCase.envVars))
+	for key := range testCase.envVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var locals strings.Builder
+	locals.WriteString("\nlocals {\n\twarehouse = {\n")
+	for _, key := range keys {
+		value := strings.TrimSpace(os.Getenv(testCase.envVars[key]))
+		if value == "" {
+			return "", fmt.Errorf("%s environment variable is not set", testCase.envVars[key])
+		}
+		fmt.Fprintf(&locals, "\t\t%s = %q\n", key, value)
+	}
+	locals.WriteString("\t}\n}\n")
+	return locals.String(), nil
+}
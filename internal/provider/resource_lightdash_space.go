@@ -138,7 +138,7 @@ func (r *spaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"is_private": schema.BoolAttribute{
-				MarkdownDescription: "Whether the space is private (`true`) or public (`false`). Note: This setting is ignored for nested spaces which inherit visibility.",
+				MarkdownDescription: "Whether the space is private (`true`) or public (`false`). Charts and dashboards created in the space don't have their own access settings, so this is the default access of new content: only the members in `access` can see it when private, and the whole project can see it when public. Note: This setting is ignored for nested spaces which inherit visibility.",
 				Optional:            true,
 				Computed:            true,
 			},
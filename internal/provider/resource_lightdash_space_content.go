@@ -0,0 +1,350 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	apiv2 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v2"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &spaceContentResource{}
+	_ resource.ResourceWithConfigure   = &spaceContentResource{}
+	_ resource.ResourceWithImportState = &spaceContentResource{}
+)
+
+func NewSpaceContentResource() resource.Resource {
+	return &spaceContentResource{}
+}
+
+// spaceContentResource defines the resource implementation.
+type spaceContentResource struct {
+	client *api.Client
+}
+
+// spaceContentResourceModel describes the resource data model.
+type spaceContentResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectUUID    types.String `tfsdk:"project_uuid"`
+	SpaceUUID      types.String `tfsdk:"space_uuid"`
+	ChartUUIDs     types.Set    `tfsdk:"chart_uuids"`
+	DashboardUUIDs types.Set    `tfsdk:"dashboard_uuids"`
+}
+
+func (r *spaceContentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_content"
+}
+
+func (r *spaceContentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_space_content.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the charts and dashboards stored in a Lightdash space",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/spaces/<space_uuid>/content`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space that stores the content.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chart_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the saved charts to move into the space.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"dashboard_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the dashboards to move into the space.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *spaceContentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *spaceContentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan spaceContentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Move all the content into the space
+	if err := r.reconcileContent(ctx, &plan, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error moving content into space",
+			fmt.Sprintf("Could not move content into space %s, unexpected error: %s", plan.SpaceUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSpaceContentResourceId(plan.ProjectUUID.ValueString(), plan.SpaceUUID.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *spaceContentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state spaceContentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the space with its content
+	space, err := apiv1.GetSpaceV1(r.client, state.ProjectUUID.ValueString(), state.SpaceUUID.ValueString())
+	if err != nil {
+		// If the space is not found, remove the resource from state
+		if statusCode, _ := api.StatusCodeOf(err); statusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading space content",
+			"Could not read space content ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only keep the managed content that is still in the space, so content moved out in the UI shows up as drift
+	var stateChartUuids, stateDashboardUuids []string
+	resp.Diagnostics.Append(state.ChartUUIDs.ElementsAs(ctx, &stateChartUuids, false)...)
+	resp.Diagnostics.Append(state.DashboardUUIDs.ElementsAs(ctx, &stateDashboardUuids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	chartUuids, diags := keepContentInSpace(ctx, state.ChartUUIDs, stateChartUuids, space.Queries)
+	resp.Diagnostics.Append(diags...)
+	dashboardUuids, diags := keepContentInSpace(ctx, state.DashboardUUIDs, stateDashboardUuids, space.Dashboards)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ChartUUIDs = chartUuids
+	state.DashboardUUIDs = dashboardUuids
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *spaceContentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state spaceContentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Move the content that is not in the space yet
+	if err := r.reconcileContent(ctx, &plan, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error moving content into space",
+			fmt.Sprintf("Could not move content into space %s, unexpected error: %s", plan.SpaceUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *spaceContentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Content can't be moved out of a space without a destination, so it stays where it is.
+	// This only removes the resource from the state.
+	tflog.Info(ctx, "Removing space content from the state without moving any content")
+}
+
+func (r *spaceContentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Extract the resource ID
+	extractedStrings, err := extractSpaceContentResourceId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error extracting resource ID",
+			"Could not extract resource ID, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	projectUuid := extractedStrings[0]
+	spaceUuid := extractedStrings[1]
+
+	// Import all the content currently stored in the space
+	space, err := apiv1.GetSpaceV1(r.client, projectUuid, spaceUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting space",
+			fmt.Sprintf("Could not get space with project UUID %s and space UUID %s, unexpected error: %s", projectUuid, spaceUuid, err.Error()),
+		)
+		return
+	}
+	chartUuids, diags := types.SetValueFrom(ctx, types.StringType, getSpaceContentUuids(space.Queries))
+	resp.Diagnostics.Append(diags...)
+	dashboardUuids, diags := types.SetValueFrom(ctx, types.StringType, getSpaceContentUuids(space.Dashboards))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the resource attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_uuid"), spaceUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("chart_uuids"), chartUuids)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_uuids"), dashboardUuids)...)
+}
+
+// reconcileContent moves the charts and dashboards of the plan that are not in the state yet into the space
+func (r *spaceContentResource) reconcileContent(ctx context.Context, plan *spaceContentResourceModel, state *spaceContentResourceModel) error {
+	var planChartUuids, planDashboardUuids, stateChartUuids, stateDashboardUuids []string
+	if diags := plan.ChartUUIDs.ElementsAs(ctx, &planChartUuids, false); diags.HasError() {
+		return fmt.Errorf("could not read chart_uuids from the plan")
+	}
+	if diags := plan.DashboardUUIDs.ElementsAs(ctx, &planDashboardUuids, false); diags.HasError() {
+		return fmt.Errorf("could not read dashboard_uuids from the plan")
+	}
+	if state != nil {
+		if diags := state.ChartUUIDs.ElementsAs(ctx, &stateChartUuids, false); diags.HasError() {
+			return fmt.Errorf("could not read chart_uuids from the state")
+		}
+		if diags := state.DashboardUUIDs.ElementsAs(ctx, &stateDashboardUuids, false); diags.HasError() {
+			return fmt.Errorf("could not read dashboard_uuids from the state")
+		}
+	}
+
+	spaceService := services.NewSpaceService(r.client)
+	projectUuid := plan.ProjectUUID.ValueString()
+	spaceUuid := plan.SpaceUUID.ValueString()
+	for _, chartUuid := range subtractStringList(planChartUuids, stateChartUuids) {
+		tflog.Info(ctx, fmt.Sprintf("Moving chart %s into space %s", chartUuid, spaceUuid))
+		if err := spaceService.MoveContentToSpace(ctx, projectUuid, apiv2.ContentTypeChart, chartUuid, spaceUuid); err != nil {
+			return err
+		}
+	}
+	for _, dashboardUuid := range subtractStringList(planDashboardUuids, stateDashboardUuids) {
+		tflog.Info(ctx, fmt.Sprintf("Moving dashboard %s into space %s", dashboardUuid, spaceUuid))
+		if err := spaceService.MoveContentToSpace(ctx, projectUuid, apiv2.ContentTypeDashboard, dashboardUuid, spaceUuid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keepContentInSpace returns the managed UUIDs that are still stored in the space
+func keepContentInSpace(ctx context.Context, current types.Set, managedUuids []string, items []apiv1.SpaceContentItem) (types.Set, diag.Diagnostics) {
+	if current.IsNull() {
+		return current, nil
+	}
+	inSpace := map[string]bool{}
+	for _, item := range items {
+		inSpace[item.UUID] = true
+	}
+	kept := []string{}
+	for _, uuid := range managedUuids {
+		if inSpace[uuid] {
+			kept = append(kept, uuid)
+		}
+	}
+	return types.SetValueFrom(ctx, types.StringType, kept)
+}
+
+func getSpaceContentUuids(items []apiv1.SpaceContentItem) []string {
+	uuids := make([]string, 0, len(items))
+	for _, item := range items {
+		uuids = append(uuids, item.UUID)
+	}
+	sort.Strings(uuids)
+	return uuids
+}
+
+func getSpaceContentResourceId(projectUuid string, spaceUuid string) string {
+	return fmt.Sprintf("projects/%s/spaces/%s/content", projectUuid, spaceUuid)
+}
+
+func extractSpaceContentResourceId(input string) ([]string, error) {
+	// Extract the captured groups
+	pattern := `^projects/([^/]+)/spaces/([^/]+)/content$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+
+	// Return the captured strings
+	projectUuid := groups[0]
+	spaceUuid := groups[1]
+	return []string{projectUuid, spaceUuid}, nil
+}
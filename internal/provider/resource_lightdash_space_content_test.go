@@ -0,0 +1,165 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestKeepContentInSpace(t *testing.T) {
+	stringSet := func(values ...string) types.Set {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+	items := []apiv1.SpaceContentItem{{UUID: "chart-a"}, {UUID: "chart-c"}}
+
+	tests := []struct {
+		name         string
+		current      types.Set
+		managedUuids []string
+		expected     types.Set
+	}{
+		{
+			name:         "all content still in the space",
+			current:      stringSet("chart-a", "chart-c"),
+			managedUuids: []string{"chart-a", "chart-c"},
+			expected:     stringSet("chart-a", "chart-c"),
+		},
+		{
+			name:         "content moved out in the UI",
+			current:      stringSet("chart-a", "chart-b"),
+			managedUuids: []string{"chart-a", "chart-b"},
+			expected:     stringSet("chart-a"),
+		},
+		{
+			name:         "unmanaged content is ignored",
+			current:      stringSet(),
+			managedUuids: []string{},
+			expected:     stringSet(),
+		},
+		{
+			name:     "null set",
+			current:  types.SetNull(types.StringType),
+			expected: types.SetNull(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept, diags := keepContentInSpace(context.Background(), tt.current, tt.managedUuids, items)
+			if diags.HasError() {
+				t.Fatalf("keepContentInSpace() errors = %v", diags.Errors())
+			}
+			if !kept.Equal(tt.expected) {
+				t.Errorf("keepContentInSpace() = %s, want %s", kept, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtractSpaceContentResourceId(t *testing.T) {
+	id := getSpaceContentResourceId("project-uuid", "space-uuid")
+	extracted, err := extractSpaceContentResourceId(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if extracted[0] != "project-uuid" || extracted[1] != "space-uuid" {
+		t.Errorf("extractSpaceContentResourceId(%q) = %v", id, extracted)
+	}
+
+	if _, err := extractSpaceContentResourceId("projects/project-uuid/spaces/space-uuid"); err == nil {
+		t.Error("Expected an error for an ID without the content suffix")
+	}
+}
+
+func TestSpaceContentResourceRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		expectRemoved bool
+		expected      types.Set
+	}{
+		{
+			name:     "content moved out in the UI",
+			status:   http.StatusOK,
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("chart-a")}),
+		},
+		{
+			name:          "deleted space",
+			status:        http.StatusNotFound,
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/projects/project-uuid/spaces/space-uuid" {
+					t.Errorf("Unexpected path: %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"status": "ok", "results": {"uuid": "space-uuid", "queries": [{"uuid": "chart-a"}], "dashboards": []}}`))
+			}))
+			defer server.Close()
+
+			client, err := api.NewClientWithHTTPClient(&server.URL, nil, nil, server.Client())
+			if err != nil {
+				t.Fatalf("Error creating client: %s", err.Error())
+			}
+			ctx := context.Background()
+			state := newResourceState(t, NewSpaceContentResource(), map[string]attr.Value{
+				"id":              types.StringValue("projects/project-uuid/spaces/space-uuid/content"),
+				"project_uuid":    types.StringValue("project-uuid"),
+				"space_uuid":      types.StringValue("space-uuid"),
+				"chart_uuids":     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("chart-a"), types.StringValue("chart-b")}),
+				"dashboard_uuids": types.SetNull(types.StringType),
+			})
+			resp := &resource.ReadResponse{State: state}
+			(&spaceContentResource{client: client}).Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() errors = %v", resp.Diagnostics.Errors())
+			}
+			if resp.State.Raw.IsNull() != tt.expectRemoved {
+				t.Fatalf("Read() removed = %v, expectRemoved %v", resp.State.Raw.IsNull(), tt.expectRemoved)
+			}
+			if tt.expectRemoved {
+				return
+			}
+
+			var chartUuids, dashboardUuids types.Set
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("chart_uuids"), &chartUuids)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("dashboard_uuids"), &dashboardUuids)...)
+			if !chartUuids.Equal(tt.expected) {
+				t.Errorf("Read() chart_uuids = %s, want %s", chartUuids, tt.expected)
+			}
+			if !dashboardUuids.IsNull() {
+				t.Errorf("Read() dashboard_uuids = %s, want null", dashboardUuids)
+			}
+		})
+	}
+}
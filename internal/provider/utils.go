@@ -18,6 +18,7 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -26,6 +27,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -72,6 +74,28 @@ func getLightdashUrl() (*string, error) {
 	return &url, nil
 }
 
+// normalizeHostUrl trims trailing slashes from the Lightdash host and makes sure
+// it is an absolute http(s) URL, so that request paths can be appended safely.
+func normalizeHostUrl(host string) (string, error) {
+	trimmed := strings.TrimRight(strings.TrimSpace(host), "/")
+	if trimmed == "" {
+		return "", fmt.Errorf("host must not be empty")
+	}
+
+	parsed, err := url.Parse(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("host %q is not a valid URL: %w", host, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("host %q must use the http or https scheme", host)
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("host %q is missing a hostname", host)
+	}
+
+	return trimmed, nil
+}
+
 func extractStrings(input, pattern string) ([]string, error) {
 	// Compile the regular expression
 	regex, err := regexp.Compile(pattern)
@@ -208,6 +232,15 @@ func subtractStringList(list1, list2 []string) []string {
 	return result
 }
 
+// toStringValues converts a slice of strings to framework string values
+func toStringValues(values []string) []types.String {
+	converted := make([]types.String, 0, len(values))
+	for _, value := range values {
+		converted = append(converted, types.StringValue(value))
+	}
+	return converted
+}
+
 // readMarkdownDescription reads the content of a markdown file from the embedded filesystem.
 // The filename parameter should be in the format "internal/provider/docs/..." or "docs/..."
 func readMarkdownDescription(ctx context.Context, filename string) (string, error) {
@@ -233,3 +266,21 @@ func readMarkdownDescription(ctx context.Context, filename string) (string, erro
 
 	return string(content), nil
 }
+
+// sortByUUID sorts the items by UUID, so that the lists exposed by the data sources have a deterministic order.
+func sortByUUID[T any](items []T, getUUID func(T) types.String) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return getUUID(items[i]).ValueString() < getUUID(items[j]).ValueString()
+	})
+}
+
+// sortByName sorts the items by name, then by UUID for the items with the same name.
+func sortByName[T any](items []T, getName func(T) types.String, getUUID func(T) types.String) {
+	sort.SliceStable(items, func(i, j int) bool {
+		nameI, nameJ := getName(items[i]).ValueString(), getName(items[j]).ValueString()
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return getUUID(items[i]).ValueString() < getUUID(items[j]).ValueString()
+	})
+}
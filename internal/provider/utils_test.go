@@ -19,6 +19,8 @@ import (
 	"os"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestIsIntegrationTestMode(t *testing.T) {
@@ -197,3 +199,91 @@ func TestReadMarkdownDescriptionEmbedded(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeHostUrl(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{input: "https://app.lightdash.cloud", expected: "https://app.lightdash.cloud"},
+		{input: "https://app.lightdash.cloud/", expected: "https://app.lightdash.cloud"},
+		{input: "https://app.lightdash.cloud///", expected: "https://app.lightdash.cloud"},
+		{input: " http://localhost:8080/ ", expected: "http://localhost:8080"},
+		{input: "https://example.com/lightdash/", expected: "https://example.com/lightdash"},
+		{input: "app.lightdash.cloud", wantErr: true},
+		{input: "ftp://app.lightdash.cloud", wantErr: true},
+		{input: "https://", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		output, err := normalizeHostUrl(test.input)
+		if (err != nil) != test.wantErr {
+			t.Errorf("Input: %q, Expected error: %v, Got error: %v", test.input, test.wantErr, err)
+			continue
+		}
+		if output != test.expected {
+			t.Errorf("Input: %q, Expected: %q, Got: %q", test.input, test.expected, output)
+		}
+	}
+}
+
+type sortableTestItem struct {
+	UUID types.String
+	Name types.String
+}
+
+func TestSortByUUID(t *testing.T) {
+	getUUID := func(item sortableTestItem) types.String { return item.UUID }
+
+	// Two reads of the same data returned in a different order
+	firstRead := []sortableTestItem{
+		{UUID: types.StringValue("c"), Name: types.StringValue("Marketing")},
+		{UUID: types.StringValue("a"), Name: types.StringValue("Sales")},
+		{UUID: types.StringValue("b"), Name: types.StringValue("Finance")},
+	}
+	secondRead := []sortableTestItem{firstRead[1], firstRead[2], firstRead[0]}
+
+	sortByUUID(firstRead, getUUID)
+	sortByUUID(secondRead, getUUID)
+
+	if !reflect.DeepEqual(firstRead, secondRead) {
+		t.Errorf("sortByUUID() produced different orders: %v and %v", firstRead, secondRead)
+	}
+	expected := []string{"a", "b", "c"}
+	for i, item := range firstRead {
+		if item.UUID.ValueString() != expected[i] {
+			t.Errorf("sortByUUID() = %v, want UUIDs %v", firstRead, expected)
+			break
+		}
+	}
+}
+
+func TestSortByName(t *testing.T) {
+	getName := func(item sortableTestItem) types.String { return item.Name }
+	getUUID := func(item sortableTestItem) types.String { return item.UUID }
+
+	// Two reads of the same data returned in a different order, with duplicated names
+	firstRead := []sortableTestItem{
+		{UUID: types.StringValue("d"), Name: types.StringValue("Sales")},
+		{UUID: types.StringValue("b"), Name: types.StringValue("Finance")},
+		{UUID: types.StringValue("c"), Name: types.StringValue("Sales")},
+		{UUID: types.StringValue("a"), Name: types.StringValue("Marketing")},
+	}
+	secondRead := []sortableTestItem{firstRead[2], firstRead[3], firstRead[0], firstRead[1]}
+
+	sortByName(firstRead, getName, getUUID)
+	sortByName(secondRead, getName, getUUID)
+
+	if !reflect.DeepEqual(firstRead, secondRead) {
+		t.Errorf("sortByName() produced different orders: %v and %v", firstRead, secondRead)
+	}
+	expected := []string{"b", "a", "c", "d"}
+	for i, item := range firstRead {
+		if item.UUID.ValueString() != expected[i] {
+			t.Errorf("sortByName() = %v, want UUIDs %v", firstRead, expected)
+			break
+		}
+	}
+}
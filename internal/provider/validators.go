@@ -17,9 +17,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+	// Embed the IANA time zone database, since it may be missing where Terraform runs
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
 // ValidateNonEmptyString validates that a string attribute is not empty or null.
@@ -56,3 +61,214 @@ func (v ValidateNonEmptyString) ValidateString(ctx context.Context, req validato
 		return
 	}
 }
+
+// ValidateDbtSelector validates that a dbt selector is not empty and is syntactically well-formed.
+// It only checks the basic grammar, as a malformed selector silently compiles an empty project.
+type ValidateDbtSelector struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v ValidateDbtSelector) Description(ctx context.Context) string {
+	return "string must be a well-formed dbt selector"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v ValidateDbtSelector) MarkdownDescription(ctx context.Context) string {
+	return "string must be a well-formed dbt selector"
+}
+
+// ValidateString performs the validation.
+func (v ValidateDbtSelector) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateDbtSelector(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid dbt Selector",
+			fmt.Sprintf("%s. Got: %q", err.Error(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func validateDbtSelector(selector string) error {
+	value := strings.TrimSpace(selector)
+	if value == "" {
+		return fmt.Errorf("selector cannot be empty")
+	}
+	if strings.HasPrefix(value, ",") || strings.HasSuffix(value, ",") {
+		return fmt.Errorf("selector cannot start or end with a comma")
+	}
+	for _, term := range strings.Fields(value) {
+		if strings.HasPrefix(term, ",") || strings.HasSuffix(term, ",") || strings.Contains(term, ",,") {
+			return fmt.Errorf("selector cannot contain an empty intersection around a comma")
+		}
+	}
+	if strings.Count(value, `"`)%2 != 0 || strings.Count(value, "'")%2 != 0 {
+		return fmt.Errorf("selector has unbalanced quotes")
+	}
+	return nil
+}
+
+// ValidateCronExpression validates that a string is a standard 5-field cron expression.
+// Only numeric values are supported, e.g. `0 */6 * * 1-5`.
+type ValidateCronExpression struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v ValidateCronExpression) Description(ctx context.Context) string {
+	return "string must be a 5-field cron expression"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v ValidateCronExpression) MarkdownDescription(ctx context.Context) string {
+	return "string must be a 5-field cron expression"
+}
+
+// ValidateString performs the validation.
+func (v ValidateCronExpression) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%s. Got: %q", err.Error(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// cronFieldRanges are the names and the allowed values of the fields of a cron expression.
+// The day of week accepts both 0 and 7 for Sunday.
+var cronFieldRanges = []struct {
+	name string
+	min  int
+	max  int
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+func validateCronExpression(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldRanges) {
+		return fmt.Errorf("cron expression must have %d fields, got %d", len(cronFieldRanges), len(fields))
+	}
+	for i, field := range fields {
+		fieldRange := cronFieldRanges[i]
+		for _, item := range strings.Split(field, ",") {
+			if err := validateCronItem(item, fieldRange.min, fieldRange.max); err != nil {
+				return fmt.Errorf("invalid %s field %q: %w", fieldRange.name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCronItem validates an item of a cron field: `*`, a value or a range, with an optional step
+func validateCronItem(item string, min int, max int) error {
+	valueRange, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		stepValue, err := strconv.Atoi(step)
+		if err != nil || stepValue <= 0 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+	if valueRange == "*" {
+		return nil
+	}
+
+	start, end, isRange := strings.Cut(valueRange, "-")
+	startValue, err := strconv.Atoi(start)
+	if err != nil || startValue < min || startValue > max {
+		return fmt.Errorf("value %q must be a number between %d and %d", start, min, max)
+	}
+	if !isRange {
+		return nil
+	}
+	endValue, err := strconv.Atoi(end)
+	if err != nil || endValue < min || endValue > max {
+		return fmt.Errorf("value %q must be a number between %d and %d", end, min, max)
+	}
+	if endValue < startValue {
+		return fmt.Errorf("range %q must not be reversed", valueRange)
+	}
+	return nil
+}
+
+// validateTimezone validates a timezone against the timezones supported by the Lightdash instance.
+// The IANA time zone database is used when the instance doesn't expose its supported timezones.
+func validateTimezone(timezone string, supportedTimezones []string) error {
+	if len(supportedTimezones) > 0 {
+		for _, supportedTimezone := range supportedTimezones {
+			if supportedTimezone == timezone {
+				return nil
+			}
+		}
+		return fmt.Errorf("timezone %q is not supported by the Lightdash instance", timezone)
+	}
+	// time.LoadLocation treats an empty name and "Local" as valid, but they aren't timezone names
+	if timezone == "" || timezone == "Local" {
+		return fmt.Errorf("timezone %q is not an IANA timezone name", timezone)
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("timezone %q is not an IANA timezone name", timezone)
+	}
+	return nil
+}
+
+// validateDbtVersion validates a dbt version against the versions supported by Lightdash, or the "latest" and "auto" aliases.
+func validateDbtVersion(dbtVersion string) error {
+	if dbtVersion == models.DbtVersionLatest || dbtVersion == models.DbtVersionAuto {
+		return nil
+	}
+	for _, supportedVersion := range models.SupportedDbtVersions {
+		if supportedVersion == dbtVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("dbt version %q is not supported, use one of %s, %q or %q", dbtVersion, strings.Join(models.SupportedDbtVersions, ", "), models.DbtVersionLatest, models.DbtVersionAuto)
+}
+
+// ValidateDuration validates that a string is a positive duration, such as `30m` or `1h`.
+type ValidateDuration struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v ValidateDuration) Description(ctx context.Context) string {
+	return "string must be a positive duration such as 30m or 1h"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v ValidateDuration) MarkdownDescription(ctx context.Context) string {
+	return "string must be a positive duration such as `30m` or `1h`"
+}
+
+// ValidateString performs the validation.
+func (v ValidateDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			err.Error(),
+		)
+	}
+}
+
+func validateDuration(duration string) error {
+	parsed, err := time.ParseDuration(duration)
+	if err != nil {
+		return fmt.Errorf("duration %q is not valid, use a duration such as \"30m\" or \"1h\"", duration)
+	}
+	if parsed <= 0 {
+		return fmt.Errorf("duration %q must be positive", duration)
+	}
+	return nil
+}
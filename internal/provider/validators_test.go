@@ -0,0 +1,157 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateDbtSelector(t *testing.T) {
+	tests := []struct {
+		selector    string
+		expectError bool
+	}{
+		{selector: "tag:lightdash"},
+		{selector: "marts.finance+,tag:daily"},
+		{selector: "tag:nightly path:models/marts"},
+		{selector: `"my model"`},
+		{selector: "", expectError: true},
+		{selector: "   ", expectError: true},
+		{selector: ",tag:lightdash", expectError: true},
+		{selector: "tag:lightdash,", expectError: true},
+		{selector: "tag:a,,tag:b", expectError: true},
+		{selector: "tag:a, tag:b", expectError: true},
+		{selector: `"my model`, expectError: true},
+		{selector: "'my model", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			err := validateDbtSelector(tt.selector)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateDbtSelector(%q) error = %v, expectError %v", tt.selector, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	tests := []struct {
+		expression  string
+		expectError bool
+	}{
+		{expression: "0 6 * * *"},
+		{expression: "*/15 * * * *"},
+		{expression: "0 */6 * * 1-5"},
+		{expression: "30 2 1,15 * 0"},
+		{expression: "0 0 * 1-12/3 7"},
+		{expression: "", expectError: true},
+		{expression: "0 6 * *", expectError: true},
+		{expression: "0 6 * * * *", expectError: true},
+		{expression: "60 6 * * *", expectError: true},
+		{expression: "0 24 * * *", expectError: true},
+		{expression: "0 6 0 * *", expectError: true},
+		{expression: "0 6 * 13 *", expectError: true},
+		{expression: "0 6 * * 8", expectError: true},
+		{expression: "*/0 * * * *", expectError: true},
+		{expression: "0 6-2 * * *", expectError: true},
+		{expression: "0 6 * * MON", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			err := validateCronExpression(tt.expression)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateCronExpression(%q) error = %v, expectError %v", tt.expression, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	tests := []struct {
+		timezone           string
+		supportedTimezones []string
+		expectError        bool
+	}{
+		{timezone: "UTC"},
+		{timezone: "Europe/Paris"},
+		{timezone: "Asia/Tokyo"},
+		{timezone: "", expectError: true},
+		{timezone: "Local", expectError: true},
+		{timezone: "Europe/Atlantis", expectError: true},
+		// The timezones of the instance replace the IANA timezone names
+		{timezone: "Asia/Tokyo", supportedTimezones: []string{"UTC", "Asia/Tokyo"}},
+		{timezone: "Europe/Paris", supportedTimezones: []string{"UTC", "Asia/Tokyo"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			err := validateTimezone(tt.timezone, tt.supportedTimezones)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateTimezone(%q, %v) error = %v, expectError %v", tt.timezone, tt.supportedTimezones, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateDbtVersion(t *testing.T) {
+	tests := []struct {
+		dbtVersion  string
+		expectError bool
+	}{
+		{dbtVersion: "v1.4"},
+		{dbtVersion: "v1.10"},
+		{dbtVersion: "latest"},
+		{dbtVersion: "auto"},
+		{dbtVersion: "1.10", expectError: true},
+		{dbtVersion: "v0.21", expectError: true},
+		{dbtVersion: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dbtVersion, func(t *testing.T) {
+			err := validateDbtVersion(tt.dbtVersion)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateDbtVersion(%q) error = %v, expectError %v", tt.dbtVersion, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	tests := []struct {
+		duration    string
+		expectError bool
+	}{
+		{duration: "30m"},
+		{duration: "1h30m"},
+		{duration: "90s"},
+		{duration: "", expectError: true},
+		{duration: "30", expectError: true},
+		{duration: "thirty minutes", expectError: true},
+		{duration: "0s", expectError: true},
+		{duration: "-5m", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			err := validateDuration(tt.duration)
+			if (err != nil) != tt.expectError {
+				t.Errorf("validateDuration(%q) error = %v, expectError %v", tt.duration, err, tt.expectError)
+			}
+		})
+	}
+}